@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/sdcoffey/big"
@@ -17,25 +18,38 @@ type Analysis interface {
 	Analyze(*TradingRecord) float64
 }
 
-// TotalProfitAnalysis analyzes the trading record for total profit.
-type TotalProfitAnalysis struct{}
+// EquityMode describes how the profits of consecutive trades add up to equity
+type EquityMode int
+
+const (
+	// FixedSize takes every trade as it was recorded, so equity moves by the sum of the trades' profits. It's the right
+	// mode for records whose orders were sized as they were traded, whether by a constant amount or by a sizer such as
+	// AntiMartingaleSizer or VolatilityTargetSizer, since the sizing is already reflected in the order amounts.
+	FixedSize EquityMode = iota
+	// Compounding reinvests profits by scaling each trade by the equity before it relative to the starting capital,
+	// so equity is multiplied by 1 + profit / starting capital for every trade. It's the right mode for records traded
+	// at a constant size, showing what reinvesting the profits into later positions would have done.
+	Compounding
+)
+
+// TotalProfitAnalysis analyzes the trading record for total profit. In Compounding Mode, profits are reinvested as
+// described by Compounding, starting from StartingCapital; the analysis falls back to FixedSize if StartingCapital is
+// zero.
+type TotalProfitAnalysis struct {
+	Mode            EquityMode
+	StartingCapital float64
+}
 
 // Analyze analyzes the trading record for total profit.
 func (tps TotalProfitAnalysis) Analyze(record *TradingRecord) float64 {
+	if tps.Mode == Compounding && tps.StartingCapital != 0 {
+		curve := equityCurve(record, tps.StartingCapital, Compounding)
+		return curve[len(curve)-1] - tps.StartingCapital
+	}
+
 	totalProfit := big.NewDecimal(0)
 	for _, trade := range record.Trades {
-		if trade.IsClosed() {
-
-			costBasis := trade.CostBasis()
-			exitValue := trade.ExitValue()
-
-			if trade.IsLong() {
-				totalProfit = totalProfit.Add(exitValue.Sub(costBasis))
-			} else if trade.IsShort() {
-				totalProfit = totalProfit.Sub(exitValue.Sub(costBasis))
-			}
-
-		}
+		totalProfit = totalProfit.Add(trade.RealizedPnL())
 	}
 
 	return totalProfit.Float()
@@ -66,26 +80,31 @@ type LogTradesAnalysis struct {
 	io.Writer
 }
 
-// Analyze logs trades to provided io.Writer
+// Analyze logs trades to provided io.Writer. Each order leg of a closed trade is logged in turn, the first as its entrance
+// and the rest as its exits, followed by the profit realized by the trade.
 func (lta LogTradesAnalysis) Analyze(record *TradingRecord) float64 {
-	var profit big.Decimal
-	logOrder := func(trade *Position) {
-		if trade.IsShort() {
-			fmt.Fprintln(lta.Writer, fmt.Sprintf("%s - enter with sell %s (%s @ $%s)", trade.EntranceOrder().ExecutionTime.UTC().Format(time.RFC822), trade.EntranceOrder().Security, trade.EntranceOrder().Amount, trade.EntranceOrder().Price))
-			fmt.Fprintln(lta.Writer, fmt.Sprintf("%s - exit with buy %s (%s @ $%s)", trade.ExitOrder().ExecutionTime.UTC().Format(time.RFC822), trade.ExitOrder().Security, trade.ExitOrder().Amount, trade.ExitOrder().Price))
-			profit = trade.ExitValue().Sub(trade.CostBasis()).Neg()
-		} else {
-			fmt.Fprintln(lta.Writer, fmt.Sprintf("%s - enter with buy %s (%s @ $%s)", trade.EntranceOrder().ExecutionTime.UTC().Format(time.RFC822), trade.EntranceOrder().Security, trade.EntranceOrder().Amount, trade.EntranceOrder().Price))
-			fmt.Fprintln(lta.Writer, fmt.Sprintf("%s - exit with sell %s (%s @ $%s)", trade.ExitOrder().ExecutionTime.UTC().Format(time.RFC822), trade.ExitOrder().Security, trade.ExitOrder().Amount, trade.ExitOrder().Price))
-			profit = trade.ExitValue().Sub(trade.CostBasis())
+	logOrder := func(action string, order *Order) {
+		side := "buy"
+		if order.Side == SELL {
+			side = "sell"
 		}
-		fmt.Fprintln(lta.Writer, fmt.Sprintf("Profit: $%s", profit))
+
+		fmt.Fprintln(lta.Writer, fmt.Sprintf("%s - %s with %s %s (%s @ $%s)", order.ExecutionTime.UTC().Format(time.RFC822), action, side, order.Security, order.Amount, order.Price))
 	}
 
 	for _, trade := range record.Trades {
-		if trade.IsClosed() {
-			logOrder(trade)
+		if !trade.IsClosed() {
+			continue
 		}
+
+		for i, order := range trade.legs() {
+			if i == 0 {
+				logOrder("enter", order)
+			} else {
+				logOrder("exit", order)
+			}
+		}
+		fmt.Fprintln(lta.Writer, fmt.Sprintf("Profit: $%s", trade.RealizedPnL()))
 	}
 	return 0.0
 }
@@ -184,8 +203,13 @@ func (ca CommissionAnalysis) Analyze(record *TradingRecord) float64 {
 	return total.Float()
 }
 
+// OpenPLAnalysis calculates the profit of the current open position if it were closed at the last candle's close.
+// Commission and Slippage are optional estimated exit costs in percent; slippage moves the exit price against the
+// position and commission is charged on the resulting exit value. Both default to zero, giving the gross open profit.
 type OpenPLAnalysis struct {
 	LastCandle *Candle
+	Commission float64
+	Slippage   float64
 }
 
 // Analyze calculates the profit if the current open position would be closed at current price.
@@ -193,15 +217,63 @@ func (o OpenPLAnalysis) Analyze(record *TradingRecord) float64 {
 	if record.CurrentPosition().IsNew() {
 		return 0
 	}
-	var profit big.Decimal
 	trade := record.CurrentPosition()
 	amount := trade.EntranceOrder().Amount
+	var profit, exitValue big.Decimal
 	if trade.IsShort() {
-		profit = o.LastCandle.ClosePrice.Mul(amount).Sub(trade.CostBasis()).Neg()
+		exitValue = slippedPrice(o.LastCandle.ClosePrice, BUY, o.Slippage).Mul(amount)
+		profit = exitValue.Sub(trade.CostBasis()).Neg()
 	} else if trade.IsLong() {
-		profit = o.LastCandle.ClosePrice.Mul(amount).Sub(trade.CostBasis())
+		exitValue = slippedPrice(o.LastCandle.ClosePrice, SELL, o.Slippage).Mul(amount)
+		profit = exitValue.Sub(trade.CostBasis())
+	}
+	commission := exitValue.Mul(big.NewDecimal(o.Commission * 0.01))
+	return profit.Sub(commission).Float()
+}
+
+// NetProfitAnalysis analyzes the trading record for total profit net of estimated trading costs. Commission and
+// Slippage are given in percent: every fill is moved Slippage against the trade (buys fill higher, sells fill lower),
+// and Commission is charged on the value of both the entrance and the exit. With both at zero, the result is the same as
+// TotalProfitAnalysis.
+type NetProfitAnalysis struct {
+	Commission float64
+	Slippage   float64
+}
+
+// Analyze analyzes the trading record for total profit net of commission and slippage.
+func (npa NetProfitAnalysis) Analyze(record *TradingRecord) float64 {
+	commission := big.NewDecimal(npa.Commission * 0.01)
+
+	total := big.ZERO
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		entrance, exit := trade.EntranceOrder(), trade.ExitOrder()
+		costBasis := slippedPrice(entrance.Price, entrance.Side, npa.Slippage).Mul(entrance.Amount)
+		exitValue := slippedPrice(exit.Price, exit.Side, npa.Slippage).Mul(exit.Amount)
+
+		profit := exitValue.Sub(costBasis)
+		if trade.IsShort() {
+			profit = profit.Neg()
+		}
+
+		costs := costBasis.Add(exitValue).Mul(commission)
+		total = total.Add(profit.Sub(costs))
 	}
-	return profit.Float()
+
+	return total.Float()
+}
+
+// slippedPrice returns the price at which an order on the given side fills when slippage (in percent) works against it
+func slippedPrice(price big.Decimal, side OrderSide, slippage float64) big.Decimal {
+	fraction := big.NewDecimal(slippage * 0.01)
+	if side == BUY {
+		return price.Mul(big.ONE.Add(fraction))
+	}
+
+	return price.Mul(big.ONE.Sub(fraction))
 }
 
 func isProfitable(trade *Position) bool {
@@ -248,11 +320,7 @@ func (m MaxWinAnalysis) Analyze(record *TradingRecord) float64 {
 		if !isProfitable(trade) {
 			continue
 		}
-		if trade.IsShort() {
-			maxProfit = big.MaxSlice(maxProfit, trade.ExitValue().Sub(trade.CostBasis()).Neg())
-		} else {
-			maxProfit = big.MaxSlice(maxProfit, trade.ExitValue().Sub(trade.CostBasis()))
-		}
+		maxProfit = big.MaxSlice(maxProfit, trade.RealizedPnL())
 	}
 	return maxProfit.Float()
 }
@@ -265,48 +333,491 @@ func (m MaxLossAnalysis) Analyze(record *TradingRecord) float64 {
 		if isProfitable(trade) {
 			continue
 		}
-		if trade.IsShort() {
-			minProfit = big.MinSlice(minProfit, trade.ExitValue().Sub(trade.CostBasis()).Neg())
-		} else {
-			minProfit = big.MinSlice(minProfit, trade.ExitValue().Sub(trade.CostBasis()))
-		}
+		minProfit = big.MinSlice(minProfit, trade.RealizedPnL())
 	}
 	return minProfit.Float()
 }
 
+// AverageWinAnalysis returns the average profit of the winning trades in the trading record, or 0 if there were none.
 type AverageWinAnalysis struct{}
 
 func (a AverageWinAnalysis) Analyze(record *TradingRecord) float64 {
 	win := big.ZERO
-	count := len(record.Trades)
+	count := 0
 	for _, trade := range record.Trades {
 		if !isProfitable(trade) {
 			continue
 		}
 		count++
-		if trade.IsShort() {
-			win = win.Add(trade.ExitValue().Sub(trade.CostBasis()).Neg())
-		} else {
-			win = win.Add(trade.ExitValue().Sub(trade.CostBasis()))
-		}
+		win = win.Add(trade.RealizedPnL())
+	}
+	if count == 0 {
+		return 0
 	}
 	return win.Div(big.NewFromInt(count)).Float()
 }
 
+// AverageLossAnalysis returns the average loss of the losing trades in the trading record, or 0 if there were none.
 type AverageLossAnalysis struct{}
 
 func (a AverageLossAnalysis) Analyze(record *TradingRecord) float64 {
 	loss := big.ZERO
-	count := len(record.Trades)
+	count := 0
 	for _, trade := range record.Trades {
 		if isProfitable(trade) {
 			continue
 		}
-		if trade.IsShort() {
-			loss = loss.Add(trade.ExitValue().Sub(trade.CostBasis()).Neg())
+		count++
+		loss = loss.Add(trade.RealizedPnL())
+	}
+	if count == 0 {
+		return 0
+	}
+	return loss.Div(big.NewFromInt(count)).Float()
+}
+
+// ProfitFactorAnalysis returns the gross profit of the winning trades in the trading record divided by the magnitude
+// of the gross loss of the losing trades, or 0 if there were no losses.
+type ProfitFactorAnalysis struct{}
+
+// Analyze returns the profit factor of the trading record
+func (pfa ProfitFactorAnalysis) Analyze(record *TradingRecord) float64 {
+	grossProfit, grossLoss := big.ZERO, big.ZERO
+	for _, trade := range record.Trades {
+		if isProfitable(trade) {
+			grossProfit = grossProfit.Add(trade.RealizedPnL())
 		} else {
-			loss = loss.Add(trade.ExitValue().Sub(trade.CostBasis()))
+			grossLoss = grossLoss.Add(trade.RealizedPnL().Abs())
 		}
 	}
-	return loss.Div(big.NewFromInt(count)).Float()
+
+	return SafeDivide(grossProfit, grossLoss, big.ZERO).Float()
+}
+
+// SharpeRatioAnalysis returns the mean return of the strategy's equity per candle of TimeSeries divided by the standard
+// deviation of those returns. Strategy equity is StartingCapital plus the profit of every trade closed before the end
+// of each candle. The ratio is 0 if the returns have no deviation.
+//
+// PeriodsPerYear annualizes the ratio by multiplying it by the square root of the number of candles in a year, e.g.,
+// 252 for daily candles of a stock market, 365 for daily candles of a market open every day, or 52 for weekly candles.
+// When PeriodsPerYear is zero, the ratio is not annualized.
+// https://www.investopedia.com/terms/s/sharperatio.asp
+type SharpeRatioAnalysis struct {
+	TimeSeries      *TimeSeries
+	StartingCapital float64
+	PeriodsPerYear  float64
+}
+
+// Analyze returns the Sharpe ratio of the trading record
+func (sra SharpeRatioAnalysis) Analyze(record *TradingRecord) float64 {
+	returns := periodReturns(equityAtCandles(record, sra.TimeSeries, sra.StartingCapital))
+	if len(returns) == 0 {
+		return 0
+	}
+
+	mean := meanReturn(returns)
+
+	var variance float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	stdev := math.Sqrt(variance / float64(len(returns)))
+	if stdev == 0 {
+		return 0
+	}
+
+	return annualize(mean/stdev, sra.PeriodsPerYear)
+}
+
+// SortinoRatioAnalysis returns the mean return of the strategy's equity per candle of TimeSeries divided by the
+// downside deviation of those returns, which only penalizes returns below zero. Strategy equity and PeriodsPerYear are
+// treated as in SharpeRatioAnalysis. The ratio is 0 if there were no negative returns.
+// https://www.investopedia.com/terms/s/sortinoratio.asp
+type SortinoRatioAnalysis struct {
+	TimeSeries      *TimeSeries
+	StartingCapital float64
+	PeriodsPerYear  float64
+}
+
+// Analyze returns the Sortino ratio of the trading record
+func (sra SortinoRatioAnalysis) Analyze(record *TradingRecord) float64 {
+	returns := periodReturns(equityAtCandles(record, sra.TimeSeries, sra.StartingCapital))
+	if len(returns) == 0 {
+		return 0
+	}
+
+	var downside float64
+	for _, r := range returns {
+		if r < 0 {
+			downside += r * r
+		}
+	}
+	downsideDeviation := math.Sqrt(downside / float64(len(returns)))
+	if downsideDeviation == 0 {
+		return 0
+	}
+
+	return annualize(meanReturn(returns)/downsideDeviation, sra.PeriodsPerYear)
+}
+
+// annualize scales a per-period ratio by the square root of the number of periods in a year. A periodsPerYear of zero
+// leaves the ratio unchanged.
+func annualize(ratio, periodsPerYear float64) float64 {
+	if periodsPerYear == 0 {
+		return ratio
+	}
+
+	return ratio * math.Sqrt(periodsPerYear)
+}
+
+func meanReturn(returns []float64) float64 {
+	var sum float64
+	for _, r := range returns {
+		sum += r
+	}
+
+	return sum / float64(len(returns))
+}
+
+// periodReturns returns the fractional change between each consecutive pair of equity values
+func periodReturns(equity []float64) []float64 {
+	if len(equity) < 2 {
+		return nil
+	}
+
+	returns := make([]float64, len(equity)-1)
+	for i := 1; i < len(equity); i++ {
+		returns[i-1] = equity[i]/equity[i-1] - 1
+	}
+
+	return returns
+}
+
+// CaptureRatioAnalysis compares the strategy's returns to those of a benchmark. Up-capture is the strategy's average
+// return over the benchmark periods in which the benchmark rose, divided by the benchmark's average return over those
+// periods; down-capture is the same for periods in which the benchmark fell. Strategy equity is StartingCapital plus
+// the profit of every trade closed before the end of each benchmark candle.
+type CaptureRatioAnalysis struct {
+	Benchmark       *TimeSeries
+	StartingCapital float64
+}
+
+// Analyze returns the up-capture divided by the down-capture, or 0 if the down-capture is zero
+func (cra CaptureRatioAnalysis) Analyze(record *TradingRecord) float64 {
+	up, down := cra.CaptureRatios(record)
+	if down == 0 {
+		return 0
+	}
+
+	return up / down
+}
+
+// CaptureRatios returns the up-capture and down-capture of the trading record relative to the benchmark
+func (cra CaptureRatioAnalysis) CaptureRatios(record *TradingRecord) (upCapture, downCapture float64) {
+	equity := equityAtCandles(record, cra.Benchmark, cra.StartingCapital)
+
+	var upStrategy, upBenchmark, downStrategy, downBenchmark float64
+	var upCount, downCount int
+	for i := 1; i < len(cra.Benchmark.Candles); i++ {
+		benchmarkReturn := cra.Benchmark.Candles[i].ClosePrice.Div(cra.Benchmark.Candles[i-1].ClosePrice).Float() - 1
+		strategyReturn := equity[i]/equity[i-1] - 1
+
+		if benchmarkReturn > 0 {
+			upStrategy += strategyReturn
+			upBenchmark += benchmarkReturn
+			upCount++
+		} else if benchmarkReturn < 0 {
+			downStrategy += strategyReturn
+			downBenchmark += benchmarkReturn
+			downCount++
+		}
+	}
+
+	if upCount > 0 {
+		upCapture = upStrategy / upBenchmark
+	}
+	if downCount > 0 {
+		downCapture = downStrategy / downBenchmark
+	}
+
+	return upCapture, downCapture
+}
+
+// InformationRatioAnalysis returns the mean active return of the strategy, i.e., its return less that of a benchmark
+// over each candle of the Benchmark, divided by the tracking error, the standard deviation of those active returns.
+// Strategy equity is StartingCapital plus the profit of every trade closed before the end of each benchmark candle, so
+// strategy and benchmark returns are aligned by the benchmark's timestamps. PeriodsPerYear is treated as in
+// SharpeRatioAnalysis. The ratio is 0 if the active returns have no deviation.
+// https://www.investopedia.com/terms/i/informationratio.asp
+type InformationRatioAnalysis struct {
+	Benchmark       *TimeSeries
+	StartingCapital float64
+	PeriodsPerYear  float64
+}
+
+// Analyze returns the information ratio of the trading record relative to the benchmark
+func (ira InformationRatioAnalysis) Analyze(record *TradingRecord) float64 {
+	strategyReturns := periodReturns(equityAtCandles(record, ira.Benchmark, ira.StartingCapital))
+	if len(strategyReturns) == 0 {
+		return 0
+	}
+
+	activeReturns := make([]float64, len(strategyReturns))
+	for i := range activeReturns {
+		benchmarkReturn := ira.Benchmark.Candles[i+1].ClosePrice.Div(ira.Benchmark.Candles[i].ClosePrice).Float() - 1
+		activeReturns[i] = strategyReturns[i] - benchmarkReturn
+	}
+
+	mean := meanReturn(activeReturns)
+
+	var variance float64
+	for _, r := range activeReturns {
+		variance += (r - mean) * (r - mean)
+	}
+	trackingError := math.Sqrt(variance / float64(len(activeReturns)))
+	if trackingError == 0 {
+		return 0
+	}
+
+	return annualize(mean/trackingError, ira.PeriodsPerYear)
+}
+
+// equityAtCandles returns the starting capital plus the profit of every trade closed before the end of each candle in
+// the series.
+func equityAtCandles(record *TradingRecord, series *TimeSeries, startingCapital float64) []float64 {
+	equity := make([]float64, len(series.Candles))
+
+	var tradeIndex int
+	current := startingCapital
+	for i, candle := range series.Candles {
+		for ; tradeIndex < len(record.Trades); tradeIndex++ {
+			trade := record.Trades[tradeIndex]
+			if !trade.ExitOrder().ExecutionTime.Before(candle.Period.End) {
+				break
+			}
+			current += trade.RealizedPnL().Float()
+		}
+		equity[i] = current
+	}
+
+	return equity
+}
+
+// CAGRAnalysis returns the compound annual growth rate of the trading record, given as a fraction. Growth is measured
+// from StartingCapital to StartingCapital plus the total profit of all closed trades, over the time between the first
+// entrance and the last exit.
+type CAGRAnalysis struct {
+	StartingCapital float64
+}
+
+// Analyze returns the compound annual growth rate of the trading record
+func (ca CAGRAnalysis) Analyze(record *TradingRecord) float64 {
+	if len(record.Trades) == 0 || ca.StartingCapital == 0 {
+		return 0
+	}
+
+	var tp TotalProfitAnalysis
+	return compoundAnnualGrowth(ca.StartingCapital, ca.StartingCapital+tp.Analyze(record), tradingDuration(record))
+}
+
+// OutperformanceAnalysis returns the compound annual growth rate of the trading record less that of buying and holding
+// the TimeSeries with the same StartingCapital over the same time. Both rates are measured over the time between the
+// first entrance and the last exit of the record: the strategy's as calculated by CAGRAnalysis, and buying and holding
+// from the close of the candle of the first entrance to the close of the candle of the last exit. A positive value
+// means the strategy beat buying and holding.
+type OutperformanceAnalysis struct {
+	TimeSeries      *TimeSeries
+	StartingCapital float64
+}
+
+// Analyze returns the difference between the strategy's and buy-and-hold compound annual growth rates
+func (oa OutperformanceAnalysis) Analyze(record *TradingRecord) float64 {
+	if len(record.Trades) == 0 || oa.StartingCapital == 0 {
+		return 0
+	}
+
+	strategy := CAGRAnalysis{StartingCapital: oa.StartingCapital}.Analyze(record)
+
+	entryPrice := oa.TimeSeries.Candles[candleIndexAt(oa.TimeSeries, record.Trades[0].EntranceOrder().ExecutionTime)].ClosePrice
+	exitPrice := oa.TimeSeries.Candles[candleIndexAt(oa.TimeSeries, record.LastTrade().ExitOrder().ExecutionTime)].ClosePrice
+	holdingCapital := big.NewDecimal(oa.StartingCapital).Mul(exitPrice).Div(entryPrice).Float()
+	holding := compoundAnnualGrowth(oa.StartingCapital, holdingCapital, tradingDuration(record))
+
+	return strategy - holding
+}
+
+// tradingDuration returns the time between the first entrance and the last exit of the record
+func tradingDuration(record *TradingRecord) time.Duration {
+	return record.LastTrade().ExitOrder().ExecutionTime.Sub(record.Trades[0].EntranceOrder().ExecutionTime)
+}
+
+// compoundAnnualGrowth returns the annual rate, as a fraction, at which startingCapital grows to endingCapital over
+// elapsed, or 0 if no time elapsed or startingCapital is not positive. Losing all of startingCapital or more is a rate
+// of -1.
+func compoundAnnualGrowth(startingCapital, endingCapital float64, elapsed time.Duration) float64 {
+	years := elapsed.Hours() / (24 * 365.25)
+	if years <= 0 || startingCapital <= 0 {
+		return 0
+	}
+
+	if endingCapital <= 0 {
+		return -1
+	}
+
+	return math.Pow(endingCapital/startingCapital, 1/years) - 1
+}
+
+// MaximumDrawdownAnalysis returns the maximum drawdown of the equity curve of the trading record, given as a fraction
+// of the peak equity. Like the MaximumDrawdownIndicator, the value is zero or negative. The equity curve starts at
+// StartingCapital and moves by the profit of each closed trade.
+type MaximumDrawdownAnalysis struct {
+	StartingCapital float64
+}
+
+// Analyze returns the maximum drawdown of the trading record
+func (mda MaximumDrawdownAnalysis) Analyze(record *TradingRecord) float64 {
+	var maxDrawdown float64
+	_, drawdowns := UnderwaterCurve(record, mda.StartingCapital)
+	for _, drawdown := range drawdowns {
+		maxDrawdown = math.Min(maxDrawdown, drawdown)
+	}
+
+	return maxDrawdown
+}
+
+// MARRatioAnalysis returns the compound annual growth rate of the trading record divided by the magnitude of its
+// maximum drawdown, or 0 if there was no drawdown.
+type MARRatioAnalysis struct {
+	StartingCapital float64
+}
+
+// Analyze returns the MAR ratio of the trading record
+func (mra MARRatioAnalysis) Analyze(record *TradingRecord) float64 {
+	drawdown := MaximumDrawdownAnalysis{StartingCapital: mra.StartingCapital}.Analyze(record)
+	if drawdown == 0 {
+		return 0
+	}
+
+	return CAGRAnalysis{StartingCapital: mra.StartingCapital}.Analyze(record) / -drawdown
+}
+
+// equityCurve returns the starting capital followed by the equity after each closed trade in the record, with profits
+// adding up according to mode.
+func equityCurve(record *TradingRecord, startingCapital float64, mode EquityMode) []float64 {
+	curve := make([]float64, 1, len(record.Trades)+1)
+	curve[0] = startingCapital
+
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		current := curve[len(curve)-1]
+		profit := trade.RealizedPnL().Float()
+		if mode == Compounding && startingCapital != 0 {
+			profit *= current / startingCapital
+		}
+
+		curve = append(curve, current+profit)
+	}
+
+	return curve
+}
+
+// ProfitPerBarAnalysis returns the total profit of the trading record divided by the total number of bars in which a
+// position was held, summed across closed trades. Order execution times are mapped to the candles of TimeSeries.
+type ProfitPerBarAnalysis struct {
+	TimeSeries *TimeSeries
+}
+
+// Analyze returns the profit per bar held of the trading record
+func (ppba ProfitPerBarAnalysis) Analyze(record *TradingRecord) float64 {
+	var bars int
+	for _, trade := range record.Trades {
+		bars += barsHeld(ppba.TimeSeries, trade)
+	}
+
+	if bars == 0 {
+		return 0
+	}
+
+	var tp TotalProfitAnalysis
+	return tp.Analyze(record) / float64(bars)
+}
+
+// ExposureAnalysis returns the fraction of the bars in TimeSeries during which a position was held, i.e., the time in
+// market. The bars held by each closed trade are summed and divided by the length of the series.
+type ExposureAnalysis struct {
+	TimeSeries *TimeSeries
+}
+
+// Analyze returns the fraction of time in market of the trading record
+func (ea ExposureAnalysis) Analyze(record *TradingRecord) float64 {
+	if len(record.Trades) == 0 || len(ea.TimeSeries.Candles) == 0 {
+		return 0
+	}
+
+	var bars int
+	for _, trade := range record.Trades {
+		bars += barsHeld(ea.TimeSeries, trade)
+	}
+
+	return float64(bars) / float64(len(ea.TimeSeries.Candles))
+}
+
+// RMultipleAnalysis returns the average R-multiple of the closed trades in the trading record: each trade's profit
+// divided by the risk it was entered with. Since a stop rule only says when a stop is hit, not where it was placed, the
+// planned stop is captured by StopDistance, an indicator of the distance between the entrance price and the stop,
+// e.g., a multiple of the average true range as used by NewATRStopRule. It's read at the candle of TimeSeries in which
+// the trade was entered, and the trade's risk is that distance times the amount entered. Trades entered with no risk
+// are skipped, and the analysis is 0 if there are none left.
+type RMultipleAnalysis struct {
+	TimeSeries   *TimeSeries
+	StopDistance Indicator
+}
+
+// Analyze returns the average R-multiple of the trading record
+func (rma RMultipleAnalysis) Analyze(record *TradingRecord) float64 {
+	var total float64
+	var trades int
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		entrance := trade.EntranceOrder()
+		distance := rma.StopDistance.Calculate(candleIndexAt(rma.TimeSeries, entrance.ExecutionTime)).Abs()
+		risk := distance.Mul(entrance.Amount)
+		if risk.IsZero() {
+			continue
+		}
+
+		total += trade.RealizedPnL().Div(risk).Float()
+		trades++
+	}
+
+	if trades == 0 {
+		return 0
+	}
+
+	return total / float64(trades)
+}
+
+// barsHeld returns the number of bars between the entrance and exit of a closed trade
+func barsHeld(series *TimeSeries, trade *Position) int {
+	if !trade.IsClosed() {
+		return 0
+	}
+
+	return candleIndexAt(series, trade.ExitOrder().ExecutionTime) - candleIndexAt(series, trade.EntranceOrder().ExecutionTime)
+}
+
+// candleIndexAt returns the index of the last candle in the series starting at or before t, or 0 if t precedes the
+// series.
+func candleIndexAt(series *TimeSeries, t time.Time) int {
+	index := sort.Search(len(series.Candles), func(i int) bool {
+		return series.Candles[i].Period.Start.After(t)
+	}) - 1
+
+	return Max(index, 0)
 }
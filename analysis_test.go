@@ -9,6 +9,7 @@ import (
 	"bufio"
 
 	"fmt"
+	"math"
 
 	"github.com/sdcoffey/big"
 	"github.com/stretchr/testify/assert"
@@ -76,6 +77,50 @@ func TestTotalProfitAnalysis(t *testing.T) {
 
 		assert.EqualValues(t, 1.5, tpa.Analyze(record))
 	})
+
+	t.Run("Compounding", func(t *testing.T) {
+		record := NewTradingRecord()
+		for i := 0; i < 2; i++ {
+			record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10)})
+			record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(12)})
+		}
+
+		assert.EqualValues(t, 40, TotalProfitAnalysis{StartingCapital: 100}.Analyze(record))
+
+		// 100 * 1.2 * 1.2
+		assert.InDelta(t, 44, TotalProfitAnalysis{Mode: Compounding, StartingCapital: 100}.Analyze(record), 1e-9)
+
+		assert.EqualValues(t, 40, TotalProfitAnalysis{Mode: Compounding}.Analyze(record))
+	})
+}
+
+func TestNetProfitAnalysis(t *testing.T) {
+	record := NewTradingRecord()
+
+	orders := []Order{
+		{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10), ExecutionTime: time.Unix(0, 0)},
+		{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(12), ExecutionTime: time.Unix(1, 0)},
+		{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(10), ExecutionTime: time.Unix(2, 0)},
+		{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(8), ExecutionTime: time.Unix(3, 0)},
+	}
+
+	for _, order := range orders {
+		record.Operate(order)
+	}
+
+	t.Run("Matches total profit without costs", func(t *testing.T) {
+		assert.EqualValues(t, TotalProfitAnalysis{}.Analyze(record), NetProfitAnalysis{}.Analyze(record))
+	})
+
+	t.Run("Subtracts commission on both legs", func(t *testing.T) {
+		// long: 20 - (100 + 120) * 1%, short: 20 - (100 + 80) * 1%
+		assert.InDelta(t, 36, NetProfitAnalysis{Commission: 1}.Analyze(record), 1e-9)
+	})
+
+	t.Run("Subtracts slippage per fill", func(t *testing.T) {
+		// long: 10 * (11.88 - 10.1), short: 10 * (9.9 - 8.08)
+		assert.InDelta(t, 36, NetProfitAnalysis{Slippage: 1}.Analyze(record), 1e-9)
+	})
 }
 
 func TestPercentGainAnalysis(t *testing.T) {
@@ -282,6 +327,24 @@ func TestLogTradesAnalysis(t *testing.T) {
 	}
 }
 
+func TestLogTradesAnalysis_Short(t *testing.T) {
+	buffer := bytes.NewBufferString("")
+
+	record := NewTradingRecord()
+
+	now := time.Now().UTC()
+	record.Operate(Order{Side: SELL, Amount: big.NewDecimal(2), Price: big.NewDecimal(3), Security: example, ExecutionTime: now})
+	record.Operate(Order{Side: BUY, Amount: big.NewDecimal(2), Price: big.NewDecimal(2), Security: example, ExecutionTime: now.AddDate(0, 0, 1)})
+
+	LogTradesAnalysis{Writer: buffer}.Analyze(record)
+
+	expected := fmt.Sprintf("%s - enter with sell EXM (2 @ $3)\n", now.Format(time.RFC822)) +
+		fmt.Sprintf("%s - exit with buy EXM (2 @ $2)\n", now.AddDate(0, 0, 1).Format(time.RFC822)) +
+		"Profit: $2\n"
+
+	assert.EqualValues(t, expected, buffer.String())
+}
+
 func TestPeriodProfitAnalysis(t *testing.T) {
 	record := NewTradingRecord()
 
@@ -472,3 +535,481 @@ func TestBuyAndHoldAnalysis(t *testing.T) {
 		assert.EqualValues(t, 5, buyAndHoldAnalysis.Analyze(record))
 	})
 }
+
+func TestCaptureRatioAnalysis(t *testing.T) {
+	benchmark := mockTimeSeriesFl(10, 11, 10, 12)
+
+	t.Run("No trades", func(t *testing.T) {
+		cra := CaptureRatioAnalysis{Benchmark: benchmark, StartingCapital: 100}
+
+		up, down := cra.CaptureRatios(NewTradingRecord())
+		assert.EqualValues(t, 0, up)
+		assert.EqualValues(t, 0, down)
+		assert.EqualValues(t, 0, cra.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Long and short trades", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		orders := []Order{
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: benchmark.Candles[0].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11), ExecutionTime: benchmark.Candles[1].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11), ExecutionTime: benchmark.Candles[1].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: benchmark.Candles[2].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: benchmark.Candles[2].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(12), ExecutionTime: benchmark.Candles[3].Period.Start},
+		}
+
+		for _, order := range orders {
+			record.Operate(order)
+		}
+
+		cra := CaptureRatioAnalysis{Benchmark: benchmark, StartingCapital: 100}
+
+		up, down := cra.CaptureRatios(record)
+		expectedUp := (1.0/100 + 2.0/102) / (0.1 + 0.2)
+		expectedDown := (1.0 / 101) / (10.0/11 - 1)
+
+		assert.InDelta(t, expectedUp, up, 1e-9)
+		assert.InDelta(t, expectedDown, down, 1e-9)
+		assert.InDelta(t, expectedUp/expectedDown, cra.Analyze(record), 1e-9)
+	})
+}
+
+func TestInformationRatioAnalysis(t *testing.T) {
+	benchmark := mockTimeSeriesFl(10, 11, 10, 12)
+
+	t.Run("No trades", func(t *testing.T) {
+		ira := InformationRatioAnalysis{Benchmark: benchmark, StartingCapital: 100}
+
+		active := []float64{-0.1, 1.0 / 11, -0.2}
+		mean := (active[0] + active[1] + active[2]) / 3
+		var variance float64
+		for _, r := range active {
+			variance += (r - mean) * (r - mean)
+		}
+
+		assert.InDelta(t, mean/math.Sqrt(variance/3), ira.Analyze(NewTradingRecord()), 1e-9)
+	})
+
+	t.Run("Matches benchmark", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 11, 11)
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.NewDecimal(10), Price: big.NewDecimal(10), ExecutionTime: series.Candles[0].Period.Start})
+		record.Operate(Order{Side: SELL, Amount: big.NewDecimal(10), Price: big.NewDecimal(11), ExecutionTime: series.Candles[1].Period.Start})
+
+		ira := InformationRatioAnalysis{Benchmark: series, StartingCapital: 100}
+
+		assert.EqualValues(t, 0, ira.Analyze(record))
+	})
+
+	t.Run("Long and short trades", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		orders := []Order{
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: benchmark.Candles[0].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11), ExecutionTime: benchmark.Candles[1].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11), ExecutionTime: benchmark.Candles[1].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: benchmark.Candles[2].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: benchmark.Candles[2].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(12), ExecutionTime: benchmark.Candles[3].Period.Start},
+		}
+
+		for _, order := range orders {
+			record.Operate(order)
+		}
+
+		active := []float64{1.0/100 - 0.1, 1.0/101 + 1.0/11, 2.0/102 - 0.2}
+		mean := (active[0] + active[1] + active[2]) / 3
+		var variance float64
+		for _, r := range active {
+			variance += (r - mean) * (r - mean)
+		}
+		expected := mean / math.Sqrt(variance/3)
+
+		ira := InformationRatioAnalysis{Benchmark: benchmark, StartingCapital: 100}
+		assert.InDelta(t, expected, ira.Analyze(record), 1e-9)
+
+		ira.PeriodsPerYear = 252
+		assert.InDelta(t, expected*math.Sqrt(252), ira.Analyze(record), 1e-9)
+	})
+}
+
+func drawdownRecord() *TradingRecord {
+	record := NewTradingRecord()
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 24 * 7305 / 10)
+
+	orders := []Order{
+		{Side: BUY, Amount: big.NewDecimal(10), Price: big.NewDecimal(10), ExecutionTime: start},
+		{Side: SELL, Amount: big.NewDecimal(10), Price: big.NewDecimal(12), ExecutionTime: start.AddDate(0, 1, 0)},
+		{Side: BUY, Amount: big.NewDecimal(10), Price: big.NewDecimal(12), ExecutionTime: start.AddDate(0, 2, 0)},
+		{Side: SELL, Amount: big.NewDecimal(10), Price: big.NewDecimal(9), ExecutionTime: start.AddDate(0, 3, 0)},
+		{Side: SELL, Amount: big.NewDecimal(10), Price: big.NewDecimal(9), ExecutionTime: start.AddDate(0, 4, 0)},
+		{Side: BUY, Amount: big.NewDecimal(10), Price: big.NewDecimal(5.9), ExecutionTime: end},
+	}
+
+	for _, order := range orders {
+		record.Operate(order)
+	}
+
+	return record
+}
+
+func TestCAGRAnalysis(t *testing.T) {
+	t.Run("No trades", func(t *testing.T) {
+		assert.EqualValues(t, 0, CAGRAnalysis{StartingCapital: 100}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Two years", func(t *testing.T) {
+		assert.InDelta(t, 0.1, CAGRAnalysis{StartingCapital: 100}.Analyze(drawdownRecord()), 1e-9)
+	})
+
+	t.Run("Total loss", func(t *testing.T) {
+		start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10), ExecutionTime: start})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(2), ExecutionTime: start.AddDate(1, 0, 0)})
+
+		assert.EqualValues(t, -1, CAGRAnalysis{StartingCapital: 80}.Analyze(record))
+		assert.EqualValues(t, -1, CAGRAnalysis{StartingCapital: 50}.Analyze(record))
+	})
+
+	t.Run("Non-positive starting capital", func(t *testing.T) {
+		assert.EqualValues(t, 0, CAGRAnalysis{StartingCapital: 0}.Analyze(drawdownRecord()))
+		assert.EqualValues(t, 0, CAGRAnalysis{StartingCapital: -100}.Analyze(drawdownRecord()))
+	})
+}
+
+func TestCompoundAnnualGrowth(t *testing.T) {
+	twoYears := time.Hour * 24 * 7305 / 10
+
+	assert.InDelta(t, 0.1, compoundAnnualGrowth(100, 121, twoYears), 1e-9)
+	assert.EqualValues(t, -1, compoundAnnualGrowth(100, 0, twoYears))
+	assert.EqualValues(t, -1, compoundAnnualGrowth(100, -20, twoYears))
+	assert.EqualValues(t, 0, compoundAnnualGrowth(0, 121, twoYears))
+	assert.EqualValues(t, 0, compoundAnnualGrowth(-100, 121, twoYears))
+}
+
+func TestOutperformanceAnalysis(t *testing.T) {
+	record := drawdownRecord()
+	start := record.Trades[0].EntranceOrder().ExecutionTime
+	end := record.LastTrade().ExitOrder().ExecutionTime
+
+	dailySeries := func(closes map[time.Time]float64, starts ...time.Time) *TimeSeries {
+		series := NewTimeSeries()
+		for _, at := range starts {
+			candle := NewCandle(NewTimePeriod(at, time.Hour*24))
+			candle.ClosePrice = big.NewDecimal(closes[at])
+			series.AddCandle(candle)
+		}
+		return series
+	}
+
+	t.Run("No trades", func(t *testing.T) {
+		oa := OutperformanceAnalysis{TimeSeries: mockTimeSeriesFl(10, 12, 14.4), StartingCapital: 100}
+
+		assert.EqualValues(t, 0, oa.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Strategy CAGR less buy and hold CAGR", func(t *testing.T) {
+		series := dailySeries(map[time.Time]float64{start: 10, end: 14.4}, start, end)
+		oa := OutperformanceAnalysis{TimeSeries: series, StartingCapital: 100}
+
+		// strategy: 10% a year, buy and hold: 10 to 14.4 over two years, 20% a year
+		assert.InDelta(t, -0.1, oa.Analyze(record), 1e-9)
+	})
+
+	t.Run("Trades cover part of the series", func(t *testing.T) {
+		before, after := start.AddDate(-1, 0, 0), end.AddDate(1, 0, 0)
+		series := dailySeries(map[time.Time]float64{before: 5, start: 10, end: 14.4, after: 40}, before, start, end, after)
+		oa := OutperformanceAnalysis{TimeSeries: series, StartingCapital: 100}
+
+		// Buy and hold is only measured between the candles of the first entrance and the last exit
+		assert.InDelta(t, -0.1, oa.Analyze(record), 1e-9)
+	})
+}
+
+func TestMaximumDrawdownAnalysis(t *testing.T) {
+	t.Run("No trades", func(t *testing.T) {
+		assert.EqualValues(t, 0, MaximumDrawdownAnalysis{StartingCapital: 100}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Drawdown from peak", func(t *testing.T) {
+		assert.InDelta(t, -0.25, MaximumDrawdownAnalysis{StartingCapital: 100}.Analyze(drawdownRecord()), 1e-9)
+	})
+}
+
+func TestMARRatioAnalysis(t *testing.T) {
+	t.Run("No drawdown", func(t *testing.T) {
+		assert.EqualValues(t, 0, MARRatioAnalysis{StartingCapital: 100}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("CAGR over drawdown", func(t *testing.T) {
+		assert.InDelta(t, 0.4, MARRatioAnalysis{StartingCapital: 100}.Analyze(drawdownRecord()), 1e-9)
+	})
+}
+
+func TestProfitPerBarAnalysis(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3, 4, 5, 6)
+
+	t.Run("No trades", func(t *testing.T) {
+		assert.EqualValues(t, 0, ProfitPerBarAnalysis{TimeSeries: series}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Profit divided by bars held", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		orders := []Order{
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(1), ExecutionTime: series.Candles[0].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(3), ExecutionTime: series.Candles[2].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(4), ExecutionTime: series.Candles[3].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(6), ExecutionTime: series.Candles[5].Period.Start.Add(time.Millisecond)},
+		}
+
+		for _, order := range orders {
+			record.Operate(order)
+		}
+
+		assert.EqualValues(t, 1, ProfitPerBarAnalysis{TimeSeries: series}.Analyze(record))
+	})
+}
+
+func TestExposureAnalysis(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3, 4, 5, 6, 7, 8)
+
+	t.Run("No trades", func(t *testing.T) {
+		assert.EqualValues(t, 0, ExposureAnalysis{TimeSeries: series}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Fraction of bars in market", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		orders := []Order{
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(1), ExecutionTime: series.Candles[0].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(3), ExecutionTime: series.Candles[2].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(5), ExecutionTime: series.Candles[4].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(6), ExecutionTime: series.Candles[5].Period.Start},
+		}
+
+		for _, order := range orders {
+			record.Operate(order)
+		}
+
+		assert.EqualValues(t, 3.0/8.0, ExposureAnalysis{TimeSeries: series}.Analyze(record))
+	})
+}
+
+func TestOpenPLAnalysis(t *testing.T) {
+	lastCandle := func(close float64) *Candle {
+		candle := NewCandle(NewTimePeriod(time.Unix(0, 0), time.Minute))
+		candle.ClosePrice = big.NewDecimal(close)
+		return candle
+	}
+
+	t.Run("No open position", func(t *testing.T) {
+		assert.EqualValues(t, 0, OpenPLAnalysis{LastCandle: lastCandle(12)}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Long position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10)})
+
+		assert.EqualValues(t, 20, OpenPLAnalysis{LastCandle: lastCandle(12)}.Analyze(record))
+
+		netPL := OpenPLAnalysis{LastCandle: lastCandle(12), Commission: 0.5, Slippage: 1}.Analyze(record)
+		assert.InDelta(t, 18.206, netPL, 1e-9)
+	})
+
+	t.Run("Short position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(10)})
+
+		assert.EqualValues(t, 20, OpenPLAnalysis{LastCandle: lastCandle(8)}.Analyze(record))
+
+		netPL := OpenPLAnalysis{LastCandle: lastCandle(8), Commission: 0.5, Slippage: 1}.Analyze(record)
+		assert.InDelta(t, 18.796, netPL, 1e-9)
+	})
+}
+
+func mockSummaryRecord(series *TimeSeries) *TradingRecord {
+	record := NewTradingRecord()
+
+	orders := []Order{
+		{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10), ExecutionTime: series.Candles[0].Period.Start},
+		{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(12), ExecutionTime: series.Candles[1].Period.Start},
+		{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(11), ExecutionTime: series.Candles[2].Period.Start},
+		{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(9), ExecutionTime: series.Candles[3].Period.Start},
+		{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10), ExecutionTime: series.Candles[4].Period.Start},
+		{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(13), ExecutionTime: series.Candles[5].Period.Start},
+	}
+
+	for _, order := range orders {
+		record.Operate(order)
+	}
+
+	return record
+}
+
+func TestAverageWinAnalysis(t *testing.T) {
+	t.Run("No trades", func(t *testing.T) {
+		assert.EqualValues(t, 0, AverageWinAnalysis{}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Averages over winning trades only", func(t *testing.T) {
+		record := mockSummaryRecord(mockTimeSeriesFl(10, 12, 11, 9, 10, 13))
+
+		assert.EqualValues(t, 25, AverageWinAnalysis{}.Analyze(record))
+	})
+
+	t.Run("Only winning trades", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10)})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(12)})
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10)})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(14)})
+
+		assert.EqualValues(t, 30, AverageWinAnalysis{}.Analyze(record))
+	})
+
+	t.Run("Only losing trades", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(12)})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(10)})
+
+		assert.EqualValues(t, 0, AverageWinAnalysis{}.Analyze(record))
+	})
+}
+
+func TestAverageLossAnalysis(t *testing.T) {
+	t.Run("No trades", func(t *testing.T) {
+		assert.EqualValues(t, 0, AverageLossAnalysis{}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Averages over losing trades only", func(t *testing.T) {
+		record := mockSummaryRecord(mockTimeSeriesFl(10, 12, 11, 9, 10, 13))
+
+		assert.EqualValues(t, -20, AverageLossAnalysis{}.Analyze(record))
+	})
+
+	t.Run("Only losing trades", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(12)})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(10)})
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(14)})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(10)})
+
+		assert.EqualValues(t, -30, AverageLossAnalysis{}.Analyze(record))
+	})
+
+	t.Run("Only winning trades", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(10)})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(12)})
+
+		assert.EqualValues(t, 0, AverageLossAnalysis{}.Analyze(record))
+	})
+}
+
+func TestProfitFactorAnalysis(t *testing.T) {
+	t.Run("No losses", func(t *testing.T) {
+		assert.EqualValues(t, 0, ProfitFactorAnalysis{}.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Gross profit over gross loss", func(t *testing.T) {
+		record := mockSummaryRecord(mockTimeSeriesFl(10, 12, 11, 9, 10, 13))
+
+		assert.EqualValues(t, 2.5, ProfitFactorAnalysis{}.Analyze(record))
+	})
+}
+
+func TestSharpeRatioAnalysis(t *testing.T) {
+	series := mockTimeSeriesFl(10, 12, 11, 9, 10, 13)
+
+	t.Run("No trades", func(t *testing.T) {
+		sra := SharpeRatioAnalysis{TimeSeries: series, StartingCapital: 100}
+
+		assert.EqualValues(t, 0, sra.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Mean over deviation of candle returns", func(t *testing.T) {
+		sra := SharpeRatioAnalysis{TimeSeries: series, StartingCapital: 100}
+
+		assert.InDelta(t, 0.4049, sra.Analyze(mockSummaryRecord(series)), 1e-4)
+	})
+
+	t.Run("Annualized", func(t *testing.T) {
+		sra := SharpeRatioAnalysis{TimeSeries: series, StartingCapital: 100, PeriodsPerYear: 252}
+
+		assert.InDelta(t, 0.4049*math.Sqrt(252), sra.Analyze(mockSummaryRecord(series)), 1e-3)
+	})
+}
+
+func TestSortinoRatioAnalysis(t *testing.T) {
+	series := mockTimeSeriesFl(10, 12, 11, 9, 10, 13)
+
+	t.Run("No losses", func(t *testing.T) {
+		sra := SortinoRatioAnalysis{TimeSeries: series, StartingCapital: 100}
+
+		assert.EqualValues(t, 0, sra.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Mean over downside deviation of candle returns", func(t *testing.T) {
+		sra := SortinoRatioAnalysis{TimeSeries: series, StartingCapital: 100}
+
+		assert.InDelta(t, 0.8944, sra.Analyze(mockSummaryRecord(series)), 1e-4)
+	})
+
+	t.Run("Annualized", func(t *testing.T) {
+		sra := SortinoRatioAnalysis{TimeSeries: series, StartingCapital: 100, PeriodsPerYear: 4}
+
+		assert.InDelta(t, 1.7889, sra.Analyze(mockSummaryRecord(series)), 1e-4)
+	})
+}
+
+func TestRMultipleAnalysis(t *testing.T) {
+	series := mockTimeSeriesFl(10, 12, 11, 15, 14)
+
+	t.Run("No trades", func(t *testing.T) {
+		rma := RMultipleAnalysis{TimeSeries: series, StopDistance: NewConstantIndicator(1)}
+
+		assert.EqualValues(t, 0, rma.Analyze(NewTradingRecord()))
+	})
+
+	t.Run("Average of long and short trades", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		orders := []Order{
+			{Side: BUY, Amount: big.NewDecimal(2), Price: big.NewDecimal(10), ExecutionTime: series.Candles[0].Period.Start},
+			{Side: SELL, Amount: big.NewDecimal(2), Price: big.NewDecimal(12), ExecutionTime: series.Candles[1].Period.Start},
+			{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11), ExecutionTime: series.Candles[2].Period.Start},
+			{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(15), ExecutionTime: series.Candles[3].Period.Start},
+		}
+
+		for _, order := range orders {
+			record.Operate(order)
+		}
+
+		// Risk is read at the entrance candle: 2 * 1 for the long and 1 * 2 for the short
+		rma := RMultipleAnalysis{TimeSeries: series, StopDistance: NewFixedIndicator(1, 3, 2, 5, 5)}
+
+		// long: 4 / 2 = 2R, short: -4 / 2 = -2R
+		assert.InDelta(t, 0, rma.Analyze(record), 1e-9)
+
+		rma.StopDistance = NewFixedIndicator(1, 3, 4, 5, 5)
+		// long: 2R, short: -4 / 4 = -1R
+		assert.InDelta(t, 0.5, rma.Analyze(record), 1e-9)
+	})
+
+	t.Run("Skips trades without risk", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: series.Candles[0].Period.Start})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(12), ExecutionTime: series.Candles[1].Period.Start})
+
+		rma := RMultipleAnalysis{TimeSeries: series, StopDistance: NewConstantIndicator(0)}
+
+		assert.EqualValues(t, 0, rma.Analyze(record))
+	})
+}
@@ -0,0 +1,54 @@
+package techan
+
+import (
+	"time"
+
+	"github.com/sdcoffey/big"
+)
+
+// CandleBuilder accumulates individual trades, e.g., from a live tick feed, into candles of a fixed period, and appends
+// each candle to Series once its period is over.
+type CandleBuilder struct {
+	Series  *TimeSeries
+	Period  time.Duration
+	current *Candle
+}
+
+// NewCandleBuilder returns a new CandleBuilder which appends candles of the given period to series
+func NewCandleBuilder(series *TimeSeries, period time.Duration) *CandleBuilder {
+	return &CandleBuilder{
+		Series: series,
+		Period: period,
+	}
+}
+
+// AddTrade adds a trade to the candle for the period containing tradeTime. Periods are aligned to multiples of Period
+// since the zero time, as in time.Truncate. If tradeTime falls after the current candle's period, the current candle
+// is flushed first. Trades are expected in time order; a trade earlier than the current candle's period is added to
+// the current candle.
+func (cb *CandleBuilder) AddTrade(tradeTime time.Time, price, volume float64) {
+	if cb.current != nil && !tradeTime.Before(cb.current.Period.End) {
+		cb.Flush()
+	}
+
+	if cb.current == nil {
+		cb.current = NewCandle(NewTimePeriod(tradeTime.Truncate(cb.Period), cb.Period))
+	}
+
+	cb.current.AddTrade(big.NewDecimal(volume), big.NewDecimal(price))
+}
+
+// Flush appends the current candle to Series and returns it, starting a new candle with the next trade. Call Flush
+// after the last trade to emit a candle whose period is not yet over. If no trades were added since the last flush,
+// Flush returns nil.
+func (cb *CandleBuilder) Flush() *Candle {
+	candle := cb.current
+	if candle == nil {
+		return nil
+	}
+
+	cb.Series.AddCandle(candle)
+	cb.current = nil
+
+	return candle
+}
@@ -0,0 +1,63 @@
+package techan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandleBuilder(t *testing.T) {
+	start := time.Date(2021, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	t.Run("Accumulates trades into candle", func(t *testing.T) {
+		series := NewTimeSeries()
+		builder := NewCandleBuilder(series, time.Minute)
+
+		builder.AddTrade(start.Add(5*time.Second), 10, 1)
+		builder.AddTrade(start.Add(10*time.Second), 12, 2)
+		builder.AddTrade(start.Add(20*time.Second), 9, 3)
+		builder.AddTrade(start.Add(50*time.Second), 11, 4)
+
+		assert.Len(t, series.Candles, 0)
+
+		candle := builder.Flush()
+
+		assert.Len(t, series.Candles, 1)
+		assert.EqualValues(t, candle, series.LastCandle())
+		assert.True(t, start.Equal(candle.Period.Start))
+		assert.True(t, start.Add(time.Minute).Equal(candle.Period.End))
+		assert.EqualValues(t, 10, candle.OpenPrice.Float())
+		assert.EqualValues(t, 12, candle.MaxPrice.Float())
+		assert.EqualValues(t, 9, candle.MinPrice.Float())
+		assert.EqualValues(t, 11, candle.ClosePrice.Float())
+		assert.EqualValues(t, 10, candle.Volume.Float())
+		assert.EqualValues(t, 4, candle.TradeCount)
+	})
+
+	t.Run("Flushes when period rolls over", func(t *testing.T) {
+		series := NewTimeSeries()
+		builder := NewCandleBuilder(series, time.Minute)
+
+		builder.AddTrade(start, 10, 1)
+		builder.AddTrade(start.Add(time.Minute), 11, 1)
+		builder.AddTrade(start.Add(3*time.Minute+time.Second), 12, 1)
+
+		assert.Len(t, series.Candles, 2)
+		assert.True(t, start.Equal(series.Candles[0].Period.Start))
+		assert.True(t, start.Add(time.Minute).Equal(series.Candles[1].Period.Start))
+
+		builder.Flush()
+
+		assert.Len(t, series.Candles, 3)
+		assert.True(t, start.Add(3*time.Minute).Equal(series.LastCandle().Period.Start))
+		assert.EqualValues(t, 12, series.LastCandle().OpenPrice.Float())
+	})
+
+	t.Run("Flush returns nil without trades", func(t *testing.T) {
+		series := NewTimeSeries()
+
+		assert.Nil(t, NewCandleBuilder(series, time.Minute).Flush())
+		assert.Len(t, series.Candles, 0)
+	})
+}
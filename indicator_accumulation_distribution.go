@@ -0,0 +1,48 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type accumulationDistributionIndicator struct {
+	series      *TimeSeries
+	resultCache resultCache
+}
+
+// NewAccumulationDistributionIndicator returns an Indicator which returns the accumulation/distribution line of the
+// series, the running total of each candle's money flow volume. Money flow volume is the candle's volume weighted by
+// where the close falls within the candle's range, from -1 at the low to 1 at the high. Candles with no range add zero.
+// https://www.investopedia.com/terms/a/accumulationdistribution.asp
+func NewAccumulationDistributionIndicator(series *TimeSeries) Indicator {
+	return &accumulationDistributionIndicator{
+		series:      series,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+func (adi *accumulationDistributionIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(adi, index, adi.moneyFlowVolume); cachedValue != nil {
+		return *cachedValue
+	}
+
+	result := adi.Calculate(index - 1).Add(adi.moneyFlowVolume(index))
+	cacheResult(adi, index, result)
+
+	return result
+}
+
+func (adi accumulationDistributionIndicator) moneyFlowVolume(index int) big.Decimal {
+	candle := adi.series.Candles[index]
+
+	closeLow := candle.ClosePrice.Sub(candle.MinPrice)
+	highClose := candle.MaxPrice.Sub(candle.ClosePrice)
+	multiplier := SafeDivide(closeLow.Sub(highClose), candle.MaxPrice.Sub(candle.MinPrice), big.ZERO)
+
+	return multiplier.Mul(candle.Volume)
+}
+
+func (adi accumulationDistributionIndicator) cache() resultCache { return adi.resultCache }
+
+func (adi *accumulationDistributionIndicator) setCache(newCache resultCache) {
+	adi.resultCache = newCache
+}
+
+func (adi accumulationDistributionIndicator) windowSize() int { return 1 }
@@ -0,0 +1,17 @@
+package techan
+
+import "testing"
+
+func TestAccumulationDistributionIndicator(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 12, 12, 8},
+		[]float64{11, 14, 14, 9},
+		[]float64{10, 20, 24, 10},
+		[]float64{9, 10, 10, 10},
+		[]float64{11, 14, 14, 9},
+	)
+
+	adl := NewAccumulationDistributionIndicator(series)
+
+	indicatorEquals(t, []float64{0, 1, 1.8571, 1.8571, 5.8571}, adl)
+}
@@ -0,0 +1,77 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+// NewAlligatorJawIndicator returns a derivative Indicator which returns the jaw line of Bill Williams' Alligator: the
+// 13 period smoothed moving average of the median price, shifted forward by 8 bars.
+// https://www.investopedia.com/articles/trading/072115/exploring-williams-alligator-indicator.asp
+func NewAlligatorJawIndicator(series *TimeSeries) Indicator {
+	return newAlligatorLine(series, 13, 8)
+}
+
+// NewAlligatorTeethIndicator returns a derivative Indicator which returns the teeth line of Bill Williams' Alligator:
+// the 8 period smoothed moving average of the median price, shifted forward by 5 bars.
+// https://www.investopedia.com/articles/trading/072115/exploring-williams-alligator-indicator.asp
+func NewAlligatorTeethIndicator(series *TimeSeries) Indicator {
+	return newAlligatorLine(series, 8, 5)
+}
+
+// NewAlligatorLipsIndicator returns a derivative Indicator which returns the lips line of Bill Williams' Alligator:
+// the 5 period smoothed moving average of the median price, shifted forward by 3 bars.
+// https://www.investopedia.com/articles/trading/072115/exploring-williams-alligator-indicator.asp
+func NewAlligatorLipsIndicator(series *TimeSeries) Indicator {
+	return newAlligatorLine(series, 5, 3)
+}
+
+func newAlligatorLine(series *TimeSeries, window, shift int) Indicator {
+	return NewShiftIndicator(NewSmoothedMovingAverageIndicator(NewMedianPriceIndicator(series), window), shift)
+}
+
+// alligatorWarmup returns the index of the first value of an Alligator line that isn't zero for warming up
+func alligatorWarmup(window, shift int) int {
+	return window - 1 + shift
+}
+
+type gatorOscillatorIndicator struct {
+	first  Indicator
+	second Indicator
+	lower  bool
+	warmup int
+}
+
+// NewGatorOscillatorUpperIndicator returns a derivative Indicator which returns the upper histogram of the Gator
+// oscillator, the absolute difference between the Alligator's jaw and teeth lines. Together with the lower histogram,
+// it shows whether the Alligator's lines are intertwined (values near zero) or spreading apart. It's zero until both
+// lines have warmed up.
+func NewGatorOscillatorUpperIndicator(series *TimeSeries) Indicator {
+	return gatorOscillatorIndicator{
+		first:  NewAlligatorJawIndicator(series),
+		second: NewAlligatorTeethIndicator(series),
+		warmup: alligatorWarmup(13, 8),
+	}
+}
+
+// NewGatorOscillatorLowerIndicator returns a derivative Indicator which returns the lower histogram of the Gator
+// oscillator, the negated absolute difference between the Alligator's teeth and lips lines. It's zero until both lines
+// have warmed up.
+func NewGatorOscillatorLowerIndicator(series *TimeSeries) Indicator {
+	return gatorOscillatorIndicator{
+		first:  NewAlligatorTeethIndicator(series),
+		second: NewAlligatorLipsIndicator(series),
+		lower:  true,
+		warmup: alligatorWarmup(8, 5),
+	}
+}
+
+func (goi gatorOscillatorIndicator) Calculate(index int) big.Decimal {
+	if index < goi.warmup {
+		return big.ZERO
+	}
+
+	spread := goi.first.Calculate(index).Sub(goi.second.Calculate(index)).Abs()
+	if goi.lower {
+		return big.ZERO.Sub(spread)
+	}
+
+	return spread
+}
@@ -0,0 +1,65 @@
+package techan
+
+import "testing"
+
+var alligatorSeries = mockTimeSeriesFl(
+	10, 11, 12, 11, 13, 14, 15, 14, 16, 17,
+	18, 17, 19, 20, 21, 20, 22, 23, 24, 23,
+	25, 26, 27, 26, 28, 29,
+)
+
+func alligatorEquals(t *testing.T, expected []float64, indicator Indicator) {
+	for i, value := range expected {
+		decimalEquals(t, value, indicator.Calculate(i))
+	}
+}
+
+func TestAlligatorJawIndicator(t *testing.T) {
+	expected := []float64{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		14.3846, 14.8166, 15.2922, 15.6544, 16.1425, 16.67,
+	}
+
+	alligatorEquals(t, expected, NewAlligatorJawIndicator(alligatorSeries))
+}
+
+func TestAlligatorTeethIndicator(t *testing.T) {
+	expected := []float64{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 12.5, 12.9375, 13.4453, 14.0146, 14.3878, 14.9643, 15.5938, 16.2696,
+		16.7359, 17.3939, 18.0947, 18.8328, 19.3537, 20.0595,
+	}
+
+	alligatorEquals(t, expected, NewAlligatorTeethIndicator(alligatorSeries))
+}
+
+func TestAlligatorLipsIndicator(t *testing.T) {
+	expected := []float64{
+		0, 0, 0, 0, 0, 0, 0, 11.4, 11.92, 12.536,
+		12.8288, 13.463, 14.1704, 14.9363, 15.3491, 16.0793, 16.8634, 17.6907, 18.1526, 18.9221,
+		19.7377, 20.5901, 21.0721, 21.8577, 22.6861, 23.5489,
+	}
+
+	alligatorEquals(t, expected, NewAlligatorLipsIndicator(alligatorSeries))
+}
+
+func TestGatorOscillatorUpperIndicator(t *testing.T) {
+	expected := []float64{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		2.3513, 2.5773, 2.8024, 3.1785, 3.2112, 3.3895,
+	}
+
+	alligatorEquals(t, expected, NewGatorOscillatorUpperIndicator(alligatorSeries))
+}
+
+func TestGatorOscillatorLowerIndicator(t *testing.T) {
+	expected := []float64{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, -1.6704, -1.9988, -1.9038, -2.0646, -2.4756, -2.7264, -2.5588, -2.6525,
+		-3.0018, -3.1962, -2.9774, -3.0249, -3.3324, -3.4894,
+	}
+
+	alligatorEquals(t, expected, NewGatorOscillatorLowerIndicator(alligatorSeries))
+}
@@ -0,0 +1,36 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+// barsSinceLookback caps how many bars a barsSinceIndicator scans back for its rule
+const barsSinceLookback = 500
+
+type barsSinceIndicator struct {
+	rule   Rule
+	record *TradingRecord
+}
+
+// NewBarsSinceIndicator returns an Indicator which returns the number of bars since the rule was last satisfied, or
+// zero if it is satisfied at the current index. The rule is evaluated against the given record. At most 500 bars are
+// scanned back from index; if the rule was not satisfied in that time, or since the start of the series, the value is
+// big.NaN, even if the rule was satisfied more than 500 bars earlier.
+//
+// Because each Calculate walks back over earlier indices, only stateless rules, whose result depends on nothing but
+// index and record, are supported. Rules that remember earlier calls, such as those returned by NewOnceRule,
+// NewDelayedRule or NewProfitLockRule, would have their state corrupted by the backward scan.
+func NewBarsSinceIndicator(rule Rule, record *TradingRecord) Indicator {
+	return barsSinceIndicator{
+		rule:   rule,
+		record: record,
+	}
+}
+
+func (bsi barsSinceIndicator) Calculate(index int) big.Decimal {
+	for bars := 0; bars <= barsSinceLookback && bars <= index; bars++ {
+		if bsi.rule.IsSatisfied(index-bars, bsi.record) {
+			return big.NewFromInt(bars)
+		}
+	}
+
+	return big.NaN
+}
@@ -0,0 +1,42 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBarsSinceIndicator(t *testing.T) {
+	t.Run("counts bars since rule was satisfied", func(t *testing.T) {
+		values := NewFixedIndicator(5, 1, 6, 2, 3, 4, 7)
+		rule := OverIndicatorRule{First: values, Second: NewConstantIndicator(4.5)}
+
+		bsi := NewBarsSinceIndicator(rule, NewTradingRecord())
+
+		indicatorEquals(t, []float64{0, 1, 0, 1, 2, 3, 0}, bsi)
+	})
+
+	t.Run("returns NaN when rule was never satisfied", func(t *testing.T) {
+		values := NewFixedIndicator(1, 2, 6)
+		rule := OverIndicatorRule{First: values, Second: NewConstantIndicator(4.5)}
+
+		bsi := NewBarsSinceIndicator(rule, NewTradingRecord())
+
+		assert.True(t, bsi.Calculate(0).NaN())
+		assert.True(t, bsi.Calculate(1).NaN())
+		decimalEquals(t, 0, bsi.Calculate(2))
+	})
+
+	t.Run("caps lookback", func(t *testing.T) {
+		vals := make([]float64, barsSinceLookback+2)
+		vals[0] = 1
+
+		values := NewFixedIndicator(vals...)
+		rule := OverIndicatorRule{First: values, Second: NewConstantIndicator(0.5)}
+
+		bsi := NewBarsSinceIndicator(rule, NewTradingRecord())
+
+		decimalEquals(t, barsSinceLookback, bsi.Calculate(barsSinceLookback))
+		assert.True(t, bsi.Calculate(barsSinceLookback+1).NaN())
+	})
+}
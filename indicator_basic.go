@@ -87,3 +87,33 @@ func (tpi typicalPriceIndicator) Calculate(index int) big.Decimal {
 	numerator := tpi.Candles[index].MaxPrice.Add(tpi.Candles[index].MinPrice).Add(tpi.Candles[index].ClosePrice)
 	return numerator.Div(big.NewFromString("3"))
 }
+
+type medianPriceIndicator struct {
+	*TimeSeries
+}
+
+// NewMedianPriceIndicator returns an Indicator which returns the median price of a candle for a given index.
+// The median price is the midpoint of the high and low prices for a given candle.
+func NewMedianPriceIndicator(series *TimeSeries) Indicator {
+	return medianPriceIndicator{series}
+}
+
+func (mpi medianPriceIndicator) Calculate(index int) big.Decimal {
+	return candleMidpoint(mpi.Candles[index])
+}
+
+type weightedCloseIndicator struct {
+	*TimeSeries
+}
+
+// NewWeightedCloseIndicator returns an Indicator which returns the weighted close of a candle for a given index.
+// The weighted close is an average of the high, low, and close prices for a given candle, with the close counted twice.
+func NewWeightedCloseIndicator(series *TimeSeries) Indicator {
+	return weightedCloseIndicator{series}
+}
+
+func (wci weightedCloseIndicator) Calculate(index int) big.Decimal {
+	candle := wci.Candles[index]
+	numerator := candle.MaxPrice.Add(candle.MinPrice).Add(candle.ClosePrice.Mul(big.NewFromInt(2)))
+	return numerator.Div(big.NewFromInt(4))
+}
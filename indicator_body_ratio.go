@@ -0,0 +1,52 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type candlePart int
+
+const (
+	candleBody candlePart = iota
+	candleUpperWick
+	candleLowerWick
+)
+
+type candleRatioIndicator struct {
+	series *TimeSeries
+	part   candlePart
+}
+
+// NewBodyRatioIndicator returns an Indicator which returns the size of a candle's body (the distance between its open
+// and close prices) as a proportion of its range. Candles with no range return zero.
+func NewBodyRatioIndicator(series *TimeSeries) Indicator {
+	return candleRatioIndicator{series, candleBody}
+}
+
+// NewUpperWickRatioIndicator returns an Indicator which returns the size of a candle's upper shadow (the distance
+// between its high price and the higher of its open and close prices) as a proportion of its range. Candles with no
+// range return zero.
+func NewUpperWickRatioIndicator(series *TimeSeries) Indicator {
+	return candleRatioIndicator{series, candleUpperWick}
+}
+
+// NewLowerWickRatioIndicator returns an Indicator which returns the size of a candle's lower shadow (the distance
+// between the lower of its open and close prices and its low price) as a proportion of its range. Candles with no
+// range return zero.
+func NewLowerWickRatioIndicator(series *TimeSeries) Indicator {
+	return candleRatioIndicator{series, candleLowerWick}
+}
+
+func (cri candleRatioIndicator) Calculate(index int) big.Decimal {
+	candle := cri.series.Candles[index]
+
+	var size big.Decimal
+	switch cri.part {
+	case candleBody:
+		size = candle.ClosePrice.Sub(candle.OpenPrice).Abs()
+	case candleUpperWick:
+		size = candle.MaxPrice.Sub(big.MaxSlice(candle.OpenPrice, candle.ClosePrice))
+	case candleLowerWick:
+		size = big.MinSlice(candle.OpenPrice, candle.ClosePrice).Sub(candle.MinPrice)
+	}
+
+	return SafeDivide(size, candle.MaxPrice.Sub(candle.MinPrice), big.ZERO)
+}
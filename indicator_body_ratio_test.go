@@ -0,0 +1,21 @@
+package techan
+
+import "testing"
+
+var candleRatioSeries = mockTimeSeriesOCHL(
+	[]float64{10, 12, 14, 8},
+	[]float64{12, 11, 12, 10},
+	[]float64{10, 10, 10, 10},
+)
+
+func TestBodyRatioIndicator(t *testing.T) {
+	indicatorEquals(t, []float64{0.3333, 0.5, 0}, NewBodyRatioIndicator(candleRatioSeries))
+}
+
+func TestUpperWickRatioIndicator(t *testing.T) {
+	indicatorEquals(t, []float64{0.3333, 0, 0}, NewUpperWickRatioIndicator(candleRatioSeries))
+}
+
+func TestLowerWickRatioIndicator(t *testing.T) {
+	indicatorEquals(t, []float64{0.3333, 0.5, 0}, NewLowerWickRatioIndicator(candleRatioSeries))
+}
@@ -31,3 +31,55 @@ func NewBollingerLowerBandIndicator(indicator Indicator, window int, sigma float
 func (bbi bbandIndicator) Calculate(index int) big.Decimal {
 	return bbi.ma.Calculate(index).Add(bbi.stdev.Calculate(index).Mul(bbi.muladd))
 }
+
+type percentBIndicator struct {
+	indicator Indicator
+	upper     Indicator
+	lower     Indicator
+}
+
+// NewPercentBIndicator returns a derivative indicator which returns the position of the underlying indicator within its
+// bollinger bands, as (indicator - lower band) / (upper band - lower band). It's 0 at the lower band and 1 at the upper
+// band, and goes below 0 or above 1 when the indicator breaks out of the bands. While the bands have zero width, it
+// returns 0.5. https://www.investopedia.com/terms/p/percent-b.asp
+func NewPercentBIndicator(indicator Indicator, window int, sigma float64) Indicator {
+	return percentBIndicator{
+		indicator: indicator,
+		upper:     NewBollingerUpperBandIndicator(indicator, window, sigma),
+		lower:     NewBollingerLowerBandIndicator(indicator, window, sigma),
+	}
+}
+
+func (pbi percentBIndicator) Calculate(index int) big.Decimal {
+	lower := pbi.lower.Calculate(index)
+	width := pbi.upper.Calculate(index).Sub(lower)
+
+	return SafeDivide(pbi.indicator.Calculate(index).Sub(lower), width, big.NewDecimal(0.5))
+}
+
+type bandWidthIndicator struct {
+	upper  Indicator
+	lower  Indicator
+	middle Indicator
+}
+
+// NewBollingerBandWidthIndicator returns a derivative indicator which returns the width of the bollinger bands on the
+// underlying indicator relative to their middle band, as (upper band - lower band) / middle band. A multi-bar low in
+// band width signals compressed volatility, which often precedes a breakout. It returns zero while the middle band is
+// zero. https://www.investopedia.com/terms/b/bollinger-band-width.asp
+func NewBollingerBandWidthIndicator(indicator Indicator, window int, sigma float64) Indicator {
+	ma := NewSimpleMovingAverage(indicator, window)
+	stdev := NewWindowedStandardDeviationIndicator(indicator, window)
+
+	return bandWidthIndicator{
+		upper:  bbandIndicator{ma: ma, stdev: stdev, muladd: big.NewDecimal(sigma)},
+		lower:  bbandIndicator{ma: ma, stdev: stdev, muladd: big.NewDecimal(-sigma)},
+		middle: ma,
+	}
+}
+
+func (bwi bandWidthIndicator) Calculate(index int) big.Decimal {
+	width := bwi.upper.Calculate(index).Sub(bwi.lower.Calculate(index))
+
+	return SafeDivide(width, bwi.middle.Calculate(index), big.ZERO)
+}
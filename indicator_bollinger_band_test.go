@@ -109,6 +109,7 @@ func TestBollingerBandIndicator(t *testing.T) {
 	wstd := NewWindowedStandardDeviationIndicator(src, window)
 	bbUP := NewBollingerUpperBandIndicator(src, window, sigma)
 	bbLO := NewBollingerLowerBandIndicator(src, window, sigma)
+	bbWidth := NewBollingerBandWidthIndicator(src, window, sigma)
 
 	for i := window - 1; i < len(ts.Candles); i++ {
 		j := i - (window - 1)
@@ -117,5 +118,36 @@ func TestBollingerBandIndicator(t *testing.T) {
 		decimalAlmostEquals(t, big.NewFromString(BBUPs[j]), bbUP.Calculate(i), 0.01)
 		decimalAlmostEquals(t, big.NewFromString(BBLOs[j]), bbLO.Calculate(i), 0.01)
 		decimalAlmostEquals(t, big.NewFromString(BBWs[j]), bbUP.Calculate(i).Sub(bbLO.Calculate((i))), 0.01)
+		decimalAlmostEquals(t, big.NewFromString(BBWs[j]).Div(big.NewFromString(SMAs[j])), bbWidth.Calculate(i), 0.01)
 	}
 }
+
+func TestPercentBIndicator(t *testing.T) {
+	t.Run("within and outside the bands", func(t *testing.T) {
+		src := NewFixedIndicator(1, 2, 3, 4, 5, 1)
+		pbi := NewPercentBIndicator(src, 3, 2)
+
+		assert.InDelta(t, 0.8062, pbi.Calculate(4).Float(), 0.0001)
+		assert.InDelta(t, 0.1568, pbi.Calculate(5).Float(), 0.0001)
+	})
+
+	t.Run("zero band width", func(t *testing.T) {
+		pbi := NewPercentBIndicator(NewConstantIndicator(3), 3, 2)
+
+		decimalEquals(t, 0.5, pbi.Calculate(4))
+	})
+}
+
+func TestBollingerBandWidthIndicator(t *testing.T) {
+	t.Run("relative to the middle band", func(t *testing.T) {
+		bwi := NewBollingerBandWidthIndicator(NewFixedIndicator(1, 2, 3, 4, 5), 3, 2)
+
+		assert.InDelta(t, 0.8165, bwi.Calculate(4).Float(), 0.0001)
+	})
+
+	t.Run("zero middle band", func(t *testing.T) {
+		bwi := NewBollingerBandWidthIndicator(NewConstantIndicator(0), 3, 2)
+
+		decimalEquals(t, 0, bwi.Calculate(4))
+	})
+}
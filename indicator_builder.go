@@ -0,0 +1,123 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+// IndicatorBuilder chains derivative indicators fluently. Start a chain with Pipe, and end it with Build:
+//
+//	smoothedRSI := Pipe(NewClosePriceIndicator(series)).RSI(14).EMA(9).Cached().Build()
+//
+// Each method wraps the indicator built so far and returns a new IndicatorBuilder, so a builder may be reused as the
+// base of several chains.
+type IndicatorBuilder struct {
+	indicator Indicator
+}
+
+// Pipe returns an IndicatorBuilder whose chain starts with the given indicator
+func Pipe(indicator Indicator) IndicatorBuilder {
+	return IndicatorBuilder{indicator: indicator}
+}
+
+// Build returns the indicator built by the chain
+func (ib IndicatorBuilder) Build() Indicator {
+	return ib.indicator
+}
+
+// Then wraps the chain with the given function, for derivative indicators that have no method of their own
+func (ib IndicatorBuilder) Then(wrap func(Indicator) Indicator) IndicatorBuilder {
+	return Pipe(wrap(ib.indicator))
+}
+
+// SMA wraps the chain in a simple moving average over window, as in NewSimpleMovingAverage
+func (ib IndicatorBuilder) SMA(window int) IndicatorBuilder {
+	return Pipe(NewSimpleMovingAverage(ib.indicator, window))
+}
+
+// EMA wraps the chain in an exponential moving average over window, as in NewEMAIndicator
+func (ib IndicatorBuilder) EMA(window int) IndicatorBuilder {
+	return Pipe(NewEMAIndicator(ib.indicator, window))
+}
+
+// MMA wraps the chain in a modified moving average over window, as in NewMMAIndicator
+func (ib IndicatorBuilder) MMA(window int) IndicatorBuilder {
+	return Pipe(NewMMAIndicator(ib.indicator, window))
+}
+
+// RSI wraps the chain in a relative strength index over window, as in NewRelativeStrengthIndexIndicator
+func (ib IndicatorBuilder) RSI(window int) IndicatorBuilder {
+	return Pipe(NewRelativeStrengthIndexIndicator(ib.indicator, window))
+}
+
+// StandardDeviation wraps the chain in a standard deviation over window, as in
+// NewWindowedStandardDeviationIndicator
+func (ib IndicatorBuilder) StandardDeviation(window int) IndicatorBuilder {
+	return Pipe(NewWindowedStandardDeviationIndicator(ib.indicator, window))
+}
+
+// ZScore wraps the chain in a z-score over window, as in NewZScoreIndicator
+func (ib IndicatorBuilder) ZScore(window int) IndicatorBuilder {
+	return Pipe(NewZScoreIndicator(ib.indicator, window))
+}
+
+// Max wraps the chain in the maximum value over window, as in NewMaximumValueIndicator
+func (ib IndicatorBuilder) Max(window int) IndicatorBuilder {
+	return Pipe(NewMaximumValueIndicator(ib.indicator, window))
+}
+
+// Min wraps the chain in the minimum value over window, as in NewMinimumValueIndicator
+func (ib IndicatorBuilder) Min(window int) IndicatorBuilder {
+	return Pipe(NewMinimumValueIndicator(ib.indicator, window))
+}
+
+// PercentChange wraps the chain in its percent change from the previous index, as in NewPercentChangeIndicator
+func (ib IndicatorBuilder) PercentChange() IndicatorBuilder {
+	return Pipe(NewPercentChangeIndicator(ib.indicator))
+}
+
+// Shift wraps the chain in a shift of the given number of indices, as in NewShiftIndicator
+func (ib IndicatorBuilder) Shift(shift int) IndicatorBuilder {
+	return Pipe(NewShiftIndicator(ib.indicator, shift))
+}
+
+// Minus wraps the chain in its difference from the given indicator, as in NewDifferenceIndicator
+func (ib IndicatorBuilder) Minus(subtrahend Indicator) IndicatorBuilder {
+	return Pipe(NewDifferenceIndicator(ib.indicator, subtrahend))
+}
+
+// Transform wraps the chain in an indicator that applies fn to each value, as in NewTransformIndicator
+func (ib IndicatorBuilder) Transform(fn func(big.Decimal) big.Decimal) IndicatorBuilder {
+	return Pipe(NewTransformIndicator(ib.indicator, fn))
+}
+
+// Cached wraps the chain in an indicator that remembers each value the first time it is calculated, which is worth
+// doing before feeding an expensive chain into several others. The indicator returned by Build should not be shared
+// between series.
+func (ib IndicatorBuilder) Cached() IndicatorBuilder {
+	return Pipe(&memoizedIndicator{
+		indicator:   ib.indicator,
+		resultCache: make([]*big.Decimal, 1000),
+	})
+}
+
+type memoizedIndicator struct {
+	indicator   Indicator
+	resultCache resultCache
+}
+
+func (mi *memoizedIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(mi, index, mi.indicator.Calculate); cachedValue != nil {
+		return *cachedValue
+	}
+
+	result := mi.indicator.Calculate(index)
+	cacheResult(mi, index, result)
+
+	return result
+}
+
+func (mi memoizedIndicator) cache() resultCache { return mi.resultCache }
+
+func (mi *memoizedIndicator) setCache(newCache resultCache) {
+	mi.resultCache = newCache
+}
+
+func (mi memoizedIndicator) windowSize() int { return 0 }
@@ -0,0 +1,54 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingIndicator struct {
+	Indicator
+	calls int
+}
+
+func (ci *countingIndicator) Calculate(index int) big.Decimal {
+	ci.calls++
+	return ci.Indicator.Calculate(index)
+}
+
+func TestIndicatorBuilder(t *testing.T) {
+	t.Run("Build returns piped indicator", func(t *testing.T) {
+		ind := NewFixedIndicator(1, 2, 3)
+
+		assert.EqualValues(t, ind, Pipe(ind).Build())
+	})
+
+	t.Run("Chains wrap previous indicator", func(t *testing.T) {
+		close := NewClosePriceIndicator(mockedTimeSeries)
+
+		expected := NewEMAIndicator(NewRelativeStrengthIndexIndicator(close, 5), 3)
+		built := Pipe(close).RSI(5).EMA(3).Build()
+
+		for i := range mockedTimeSeries.Candles {
+			assert.EqualValues(t, expected.Calculate(i).String(), built.Calculate(i).String())
+		}
+	})
+
+	t.Run("Then wraps with arbitrary indicator", func(t *testing.T) {
+		built := Pipe(NewFixedIndicator(1, 2, 3, 6)).Then(NewGainIndicator).SMA(2).Build()
+
+		indicatorEquals(t, []float64{0, 0.5, 1, 2}, built)
+	})
+
+	t.Run("Cached remembers values", func(t *testing.T) {
+		counting := &countingIndicator{Indicator: NewFixedIndicator(1, 2, 3)}
+		cached := Pipe(counting).Cached().Build()
+
+		decimalEquals(t, 2, cached.Calculate(1))
+		decimalEquals(t, 2, cached.Calculate(1))
+		decimalEquals(t, 3, cached.Calculate(2))
+
+		assert.EqualValues(t, 2, counting.calls)
+	})
+}
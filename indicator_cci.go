@@ -7,7 +7,8 @@ type commidityChannelIndexIndicator struct {
 	window int
 }
 
-// NewCCIIndicator Returns a new Commodity Channel Index Indicator
+// NewCCIIndicator Returns a new Commodity Channel Index Indicator. If the mean deviation over the window is zero, zero
+// is returned.
 // http://stockcharts.com/school/doku.php?id=chart_school:technical_indicators:commodity_channel_index_cci
 func NewCCIIndicator(ts *TimeSeries, window int) Indicator {
 	return commidityChannelIndexIndicator{
@@ -21,5 +22,5 @@ func (ccii commidityChannelIndexIndicator) Calculate(index int) big.Decimal {
 	typicalPriceSma := NewSimpleMovingAverage(typicalPrice, ccii.window)
 	meanDeviation := NewMeanDeviationIndicator(NewClosePriceIndicator(ccii.series), ccii.window)
 
-	return typicalPrice.Calculate(index).Sub(typicalPriceSma.Calculate(index)).Div(meanDeviation.Calculate(index).Mul(big.NewFromString("0.015")))
+	return SafeDivide(typicalPrice.Calculate(index).Sub(typicalPriceSma.Calculate(index)), meanDeviation.Calculate(index).Mul(big.NewFromString("0.015")), big.ZERO)
 }
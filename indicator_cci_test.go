@@ -27,3 +27,11 @@ func TestCommidityChannelIndexIndicator_Calculate(t *testing.T) {
 		assert.EqualValues(t, result, cci.Calculate(i+19).FormattedString(4))
 	}
 }
+
+func TestCommidityChannelIndexIndicator_ZeroRange(t *testing.T) {
+	series := mockTimeSeries("10", "10", "10", "10")
+
+	cci := NewCCIIndicator(series, 3)
+
+	assert.EqualValues(t, "0.0000", cci.Calculate(3).FormattedString(4))
+}
@@ -0,0 +1,11 @@
+package techan
+
+// NewChaikinOscillatorIndicator returns a derivative Indicator which returns the Chaikin oscillator of the series, the
+// difference between a fastWindow and a slowWindow EMA of the accumulation/distribution line. Windows of 3 and 10 are
+// typical, and crosses of the zero line are read as changes in volume momentum.
+// https://www.investopedia.com/terms/c/chaikinoscillator.asp
+func NewChaikinOscillatorIndicator(series *TimeSeries, fastWindow, slowWindow int) Indicator {
+	adl := NewAccumulationDistributionIndicator(series)
+
+	return NewDifferenceIndicator(NewEMAIndicator(adl, fastWindow), NewEMAIndicator(adl, slowWindow))
+}
@@ -0,0 +1,17 @@
+package techan
+
+import "testing"
+
+func TestChaikinOscillatorIndicator(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 12, 12, 8},
+		[]float64{11, 14, 14, 9},
+		[]float64{10, 20, 24, 10},
+		[]float64{9, 10, 10, 10},
+		[]float64{11, 14, 14, 9},
+	)
+
+	chaikin := NewChaikinOscillatorIndicator(series, 2, 3)
+
+	indicatorEquals(t, []float64{0, 0.5, 0.4524, 0.3016, 0.8426}, chaikin)
+}
@@ -0,0 +1,40 @@
+package techan
+
+import (
+	"fmt"
+
+	"github.com/sdcoffey/big"
+)
+
+type clampIndicator struct {
+	indicator Indicator
+	min       big.Decimal
+	max       big.Decimal
+}
+
+// NewClampIndicator returns a derivative Indicator which returns the value of the base indicator bounded to the range
+// [min, max]: values below min are returned as min, and values above max as max. NewClampIndicator panics if min is
+// greater than max.
+func NewClampIndicator(indicator Indicator, min, max float64) Indicator {
+	if min > max {
+		panic(fmt.Errorf("error creating clamp indicator: min (%v) must not be greater than max (%v)", min, max))
+	}
+
+	return clampIndicator{
+		indicator: indicator,
+		min:       big.NewDecimal(min),
+		max:       big.NewDecimal(max),
+	}
+}
+
+func (ci clampIndicator) Calculate(index int) big.Decimal {
+	value := ci.indicator.Calculate(index)
+	if value.LT(ci.min) {
+		return ci.min
+	}
+	if value.GT(ci.max) {
+		return ci.max
+	}
+
+	return value
+}
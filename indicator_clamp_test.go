@@ -0,0 +1,21 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampIndicator(t *testing.T) {
+	t.Run("bounds values to range", func(t *testing.T) {
+		ci := NewClampIndicator(NewFixedIndicator(-5, 0, 50, 100, 150), 0, 100)
+
+		indicatorEquals(t, []float64{0, 0, 50, 100, 100}, ci)
+	})
+
+	t.Run("panics when min is greater than max", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewClampIndicator(NewConstantIndicator(1), 2, 1)
+		})
+	})
+}
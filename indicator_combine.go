@@ -0,0 +1,24 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type combineIndicator struct {
+	a  Indicator
+	b  Indicator
+	fn func(x, y big.Decimal) big.Decimal
+}
+
+// NewCombineIndicator returns a derivative Indicator which applies fn to the values of indicators a and b at each
+// index, e.g., to weight and sum them, or to take a ratio with a custom fallback. Like NewTransformIndicator, values
+// are calculated lazily whenever they're asked for, and are not cached.
+func NewCombineIndicator(a, b Indicator, fn func(x, y big.Decimal) big.Decimal) Indicator {
+	return combineIndicator{
+		a:  a,
+		b:  b,
+		fn: fn,
+	}
+}
+
+func (ci combineIndicator) Calculate(index int) big.Decimal {
+	return ci.fn(ci.a.Calculate(index), ci.b.Calculate(index))
+}
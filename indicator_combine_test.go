@@ -0,0 +1,29 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+)
+
+func TestCombineIndicator(t *testing.T) {
+	t.Run("weighted sum", func(t *testing.T) {
+		weighted := func(x, y big.Decimal) big.Decimal {
+			return x.Mul(big.NewDecimal(0.25)).Add(y.Mul(big.NewDecimal(0.75)))
+		}
+
+		ci := NewCombineIndicator(NewFixedIndicator(4, 8, 0), NewFixedIndicator(0, 4, 8), weighted)
+
+		indicatorEquals(t, []float64{1, 5, 6}, ci)
+	})
+
+	t.Run("ratio with fallback", func(t *testing.T) {
+		ratio := func(x, y big.Decimal) big.Decimal {
+			return SafeDivide(x, y, big.ONE)
+		}
+
+		ci := NewCombineIndicator(NewFixedIndicator(4, 8), NewFixedIndicator(2, 0), ratio)
+
+		indicatorEquals(t, []float64{2, 1}, ci)
+	})
+}
@@ -0,0 +1,45 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type correlationIndicator struct {
+	a      Indicator
+	b      Indicator
+	window int
+}
+
+// NewCorrelationIndicator returns a derivative Indicator which returns the Pearson correlation coefficient of the values
+// of two indicators over the trailing window. Before a full window is available, the correlation of the available
+// values is returned. When either indicator has no variance over the window, the correlation is zero.
+// https://www.investopedia.com/terms/c/correlationcoefficient.asp
+func NewCorrelationIndicator(a, b Indicator, window int) Indicator {
+	return correlationIndicator{
+		a:      a,
+		b:      b,
+		window: window,
+	}
+}
+
+func (ci correlationIndicator) Calculate(index int) big.Decimal {
+	start := Max(index-ci.window+1, 0)
+	count := big.NewFromInt(index - start + 1)
+
+	sumA, sumB := big.ZERO, big.ZERO
+	for i := start; i <= index; i++ {
+		sumA = sumA.Add(ci.a.Calculate(i))
+		sumB = sumB.Add(ci.b.Calculate(i))
+	}
+	meanA, meanB := sumA.Div(count), sumB.Div(count)
+
+	covariance, varianceA, varianceB := big.ZERO, big.ZERO, big.ZERO
+	for i := start; i <= index; i++ {
+		devA := ci.a.Calculate(i).Sub(meanA)
+		devB := ci.b.Calculate(i).Sub(meanB)
+
+		covariance = covariance.Add(devA.Mul(devB))
+		varianceA = varianceA.Add(devA.Mul(devA))
+		varianceB = varianceB.Add(devB.Mul(devB))
+	}
+
+	return SafeDivide(covariance, varianceA.Mul(varianceB).Sqrt(), big.ZERO)
+}
@@ -0,0 +1,29 @@
+package techan
+
+import "testing"
+
+func TestCorrelationIndicator(t *testing.T) {
+	t.Run("perfectly correlated", func(t *testing.T) {
+		ci := NewCorrelationIndicator(NewFixedIndicator(1, 2, 3, 4, 5), NewFixedIndicator(2, 4, 6, 8, 10), 3)
+
+		indicatorEquals(t, []float64{0, 1, 1, 1, 1}, ci)
+	})
+
+	t.Run("inversely correlated", func(t *testing.T) {
+		ci := NewCorrelationIndicator(NewFixedIndicator(1, 2, 3, 4, 5), NewFixedIndicator(5, 4, 3, 2, 1), 3)
+
+		indicatorEquals(t, []float64{0, -1, -1, -1, -1}, ci)
+	})
+
+	t.Run("partially correlated", func(t *testing.T) {
+		ci := NewCorrelationIndicator(NewFixedIndicator(1, 2, 3, 4), NewFixedIndicator(1, 3, 2, 5), 4)
+
+		indicatorEquals(t, []float64{0, 1, 0.5, 0.8315}, ci)
+	})
+
+	t.Run("returns zero with no variance", func(t *testing.T) {
+		ci := NewCorrelationIndicator(NewFixedIndicator(1, 2, 3, 4), NewFixedIndicator(7, 7, 7, 7), 3)
+
+		indicatorEquals(t, []float64{0, 0, 0, 0}, ci)
+	})
+}
@@ -0,0 +1,28 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type disparityIndexIndicator struct {
+	indicator  Indicator
+	sma        Indicator
+	oneHundred big.Decimal
+}
+
+// NewDisparityIndexIndicator returns a derivative Indicator which returns the disparity index of the base indicator,
+// the percentage by which its current value lies above (or below) its simple moving average over the given window.
+// Values where the moving average is zero, including those before a full window is available, are zero.
+// https://www.investopedia.com/terms/d/disparityindex.asp
+func NewDisparityIndexIndicator(indicator Indicator, window int) Indicator {
+	return disparityIndexIndicator{
+		indicator:  indicator,
+		sma:        NewSimpleMovingAverage(indicator, window),
+		oneHundred: big.NewFromString("100"),
+	}
+}
+
+func (dii disparityIndexIndicator) Calculate(index int) big.Decimal {
+	sma := dii.sma.Calculate(index)
+	deviation := dii.indicator.Calculate(index).Sub(sma)
+
+	return SafeDivide(deviation, sma, big.ZERO).Mul(dii.oneHundred)
+}
@@ -0,0 +1,17 @@
+package techan
+
+import "testing"
+
+func TestDisparityIndexIndicator(t *testing.T) {
+	t.Run("percent deviation from moving average", func(t *testing.T) {
+		dii := NewDisparityIndexIndicator(NewFixedIndicator(10, 12, 14, 9, 10), 3)
+
+		indicatorEquals(t, []float64{0, 0, 16.6667, -22.8571, -9.0909}, dii)
+	})
+
+	t.Run("returns zero when moving average is zero", func(t *testing.T) {
+		dii := NewDisparityIndexIndicator(NewFixedIndicator(-1, 1, 2, -2), 2)
+
+		indicatorEquals(t, []float64{0, 0, 33.3333, 0}, dii)
+	})
+}
@@ -0,0 +1,32 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type easeOfMovementIndicator struct {
+	series *TimeSeries
+}
+
+// NewEaseOfMovementIndicator returns a derivative Indicator which returns the window simple moving average of the
+// single-period ease of movement of the series. Ease of movement is the distance the candle's midpoint moved from the
+// previous candle's midpoint, divided by the box ratio (volume divided by the candle's range). Flat candles and candles
+// without volume have an ease of movement of zero, as does the first candle.
+// https://www.investopedia.com/terms/e/easeofmovement.asp
+func NewEaseOfMovementIndicator(series *TimeSeries, window int) Indicator {
+	return NewSimpleMovingAverage(easeOfMovementIndicator{series}, window)
+}
+
+func (emi easeOfMovementIndicator) Calculate(index int) big.Decimal {
+	if index == 0 {
+		return big.ZERO
+	}
+
+	candle := emi.series.Candles[index]
+	distance := candleMidpoint(candle).Sub(candleMidpoint(emi.series.Candles[index-1]))
+	boxRatio := SafeDivide(candle.Volume, candle.MaxPrice.Sub(candle.MinPrice), big.ZERO)
+
+	return SafeDivide(distance, boxRatio, big.ZERO)
+}
+
+func candleMidpoint(candle *Candle) big.Decimal {
+	return candle.MaxPrice.Add(candle.MinPrice).Div(big.NewFromInt(2))
+}
@@ -0,0 +1,22 @@
+package techan
+
+import "testing"
+
+func TestEaseOfMovementIndicator(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 12, 12, 8},
+		[]float64{11, 14, 14, 9},
+		[]float64{10, 20, 24, 10},
+		[]float64{9, 10, 10, 10},
+		[]float64{11, 14, 14, 9},
+		[]float64{9, 10, 11, 9},
+	)
+
+	t.Run("Single period", func(t *testing.T) {
+		indicatorEquals(t, []float64{0, 7.5, 38.5, 0, 1.875, -0.6}, NewEaseOfMovementIndicator(series, 1))
+	})
+
+	t.Run("Smoothed", func(t *testing.T) {
+		indicatorEquals(t, []float64{0, 3.75, 23, 19.25, 0.9375, 0.6375}, NewEaseOfMovementIndicator(series, 2))
+	})
+}
@@ -2,10 +2,27 @@ package techan
 
 import "github.com/sdcoffey/big"
 
+// EMASeed describes how an EMAIndicator chooses its first value
+type EMASeed int
+
+// EMASeedSMA, EMASeedFirstValue, and EMASeedZero enumerations
+const (
+	// EMASeedSMA seeds the EMA with the simple moving average of the first window values, and returns zero before
+	// that. This is the seeding used by NewEMAIndicator, and matches TradingView's ta.ema and ta-lib's default.
+	EMASeedSMA EMASeed = iota
+	// EMASeedFirstValue seeds the EMA with the first value of the underlying indicator, so every index has a value.
+	// This matches ta-lib's Metastock compatibility mode and pandas' ewm(adjust=False).
+	EMASeedFirstValue
+	// EMASeedZero treats the value before the first index as zero, so the first value is alpha times the first value
+	// of the underlying indicator.
+	EMASeedZero
+)
+
 type emaIndicator struct {
 	indicator   Indicator
 	window      int
 	alpha       big.Decimal
+	seed        EMASeed
 	resultCache resultCache
 }
 
@@ -13,18 +30,24 @@ type emaIndicator struct {
 // the given windowSize, with values closer to current index given more weight. A more in-depth explanation can be found here:
 // http://www.investopedia.com/terms/e/ema.asp
 func NewEMAIndicator(indicator Indicator, window int) Indicator {
+	return NewEMAIndicatorWithSeed(indicator, window, EMASeedSMA)
+}
+
+// NewEMAIndicatorWithSeed returns an EMA indicator like NewEMAIndicator, whose first value is chosen according to the
+// given seed. The seeding only affects early values, but those can matter for short backtests, or when reconciling
+// against other tools.
+func NewEMAIndicatorWithSeed(indicator Indicator, window int, seed EMASeed) Indicator {
 	return &emaIndicator{
 		indicator:   indicator,
 		window:      window,
 		alpha:       big.ONE.Frac(2).Div(big.NewFromInt(window + 1)),
+		seed:        seed,
 		resultCache: make([]*big.Decimal, 1000),
 	}
 }
 
 func (ema *emaIndicator) Calculate(index int) big.Decimal {
-	if cachedValue := returnIfCached(ema, index, func(i int) big.Decimal {
-		return NewSimpleMovingAverage(ema.indicator, ema.window).Calculate(i)
-	}); cachedValue != nil {
+	if cachedValue := returnIfCached(ema, index, ema.seedValue); cachedValue != nil {
 		return *cachedValue
 	}
 
@@ -36,10 +59,27 @@ func (ema *emaIndicator) Calculate(index int) big.Decimal {
 	return result
 }
 
+func (ema emaIndicator) seedValue(index int) big.Decimal {
+	switch ema.seed {
+	case EMASeedFirstValue:
+		return ema.indicator.Calculate(index)
+	case EMASeedZero:
+		return ema.indicator.Calculate(index).Mul(ema.alpha)
+	default:
+		return NewSimpleMovingAverage(ema.indicator, ema.window).Calculate(index)
+	}
+}
+
 func (ema emaIndicator) cache() resultCache { return ema.resultCache }
 
 func (ema *emaIndicator) setCache(newCache resultCache) {
 	ema.resultCache = newCache
 }
 
-func (ema emaIndicator) windowSize() int { return ema.window }
+func (ema emaIndicator) windowSize() int {
+	if ema.seed == EMASeedSMA {
+		return ema.window
+	}
+
+	return 1
+}
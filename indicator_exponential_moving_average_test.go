@@ -39,6 +39,28 @@ func TestExponentialMovingAverage(t *testing.T) {
 	})
 }
 
+func TestExponentialMovingAverageSeeding(t *testing.T) {
+	values := NewFixedIndicator(2, 4, 6, 8)
+
+	t.Run("SMA", func(t *testing.T) {
+		ema := NewEMAIndicatorWithSeed(values, 3, EMASeedSMA)
+
+		indicatorEquals(t, []float64{0, 0, 4, 6}, ema)
+	})
+
+	t.Run("First value", func(t *testing.T) {
+		ema := NewEMAIndicatorWithSeed(values, 3, EMASeedFirstValue)
+
+		indicatorEquals(t, []float64{2, 3, 4.5, 6.25}, ema)
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		ema := NewEMAIndicatorWithSeed(values, 3, EMASeedZero)
+
+		indicatorEquals(t, []float64{1, 2.5, 4.25, 6.125}, ema)
+	})
+}
+
 func BenchmarkExponetialMovingAverage(b *testing.B) {
 	size := 10000
 	ts := randomTimeSeries(size)
@@ -0,0 +1,39 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type fibonacciRetracementIndicator struct {
+	series   *TimeSeries
+	lookback int
+	level    big.Decimal
+}
+
+// NewFibonacciRetracementIndicator returns a derivative Indicator which returns the price at the given retracement
+// level, typically one of 0.236, 0.382, 0.5, 0.618 or 0.786, of the most recent swing. The swing is chosen as in
+// NewRetracementRule: it runs between the highest high and the lowest low of the lookback candles ending at the current
+// one, or of every candle so far before lookback candles are available. If the high came after the low, the swing is
+// up and the level is measured down from the high, i.e., high - level * (high - low); otherwise the swing is down and
+// the level is measured up from the low, i.e., low + level * (high - low).
+// https://www.investopedia.com/terms/f/fibonacciretracement.asp
+func NewFibonacciRetracementIndicator(series *TimeSeries, lookback int, level float64) Indicator {
+	return fibonacciRetracementIndicator{
+		series:   series,
+		lookback: lookback,
+		level:    big.NewDecimal(level),
+	}
+}
+
+func (fri fibonacciRetracementIndicator) Calculate(index int) big.Decimal {
+	lookback := fri.lookback
+	if lookback > index+1 || lookback < 1 {
+		lookback = index + 1
+	}
+
+	s := findSwing(fri.series, index, lookback)
+	distance := s.high.Sub(s.low).Mul(fri.level)
+	if s.up {
+		return s.high.Sub(distance)
+	}
+
+	return s.low.Add(distance)
+}
@@ -0,0 +1,34 @@
+package techan
+
+import "testing"
+
+func TestFibonacciRetracementIndicator(t *testing.T) {
+	// Highs and lows are close +/- 1
+	t.Run("Up swing", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 20, 15)
+
+		decimalEquals(t, 16.416, NewFibonacciRetracementIndicator(series, 3, 0.382).Calculate(2))
+		decimalEquals(t, 15, NewFibonacciRetracementIndicator(series, 3, 0.5).Calculate(2))
+		decimalEquals(t, 13.584, NewFibonacciRetracementIndicator(series, 3, 0.618).Calculate(2))
+	})
+
+	t.Run("Down swing", func(t *testing.T) {
+		series := mockTimeSeriesFl(20, 10, 15)
+
+		decimalEquals(t, 13.584, NewFibonacciRetracementIndicator(series, 3, 0.382).Calculate(2))
+		decimalEquals(t, 16.416, NewFibonacciRetracementIndicator(series, 3, 0.618).Calculate(2))
+	})
+
+	t.Run("Uses available candles before lookback", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 20, 15)
+
+		decimalEquals(t, 15, NewFibonacciRetracementIndicator(series, 10, 0.5).Calculate(1))
+		decimalEquals(t, 10, NewFibonacciRetracementIndicator(series, 10, 0.5).Calculate(0))
+	})
+
+	t.Run("Only considers the lookback window", func(t *testing.T) {
+		series := mockTimeSeriesFl(30, 10, 20, 15)
+
+		decimalEquals(t, 15, NewFibonacciRetracementIndicator(series, 3, 0.5).Calculate(3))
+	})
+}
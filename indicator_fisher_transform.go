@@ -0,0 +1,99 @@
+package techan
+
+import (
+	"math"
+
+	"github.com/sdcoffey/big"
+)
+
+// NewFisherTransformIndicator returns a derivative Indicator which returns Ehlers' Fisher transform of the median price
+// of the series. The median price is normalized to the range -1 to 1 using the high and low of the median price over
+// the window, smoothed (0.33 times the new value plus 0.67 times the previous value), and clamped to ±0.999 so the
+// transform stays finite. The transform, 0.5*ln((1+x)/(1-x)), is then smoothed by adding half of its previous value.
+// https://www.investopedia.com/terms/f/fisher-transform.asp
+func NewFisherTransformIndicator(series *TimeSeries, window int) Indicator {
+	median := NewMedianPriceIndicator(series)
+
+	return &fisherTransformIndicator{
+		value: &fisherValueIndicator{
+			median:      median,
+			minValue:    NewMinimumValueIndicator(median, window),
+			maxValue:    NewMaximumValueIndicator(median, window),
+			resultCache: make([]*big.Decimal, 1000),
+		},
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+type fisherTransformIndicator struct {
+	value       Indicator
+	resultCache resultCache
+}
+
+func (fti *fisherTransformIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(fti, index, func(i int) big.Decimal {
+		return fti.transform(i, big.ZERO)
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	result := fti.transform(index, fti.Calculate(index-1))
+	cacheResult(fti, index, result)
+
+	return result
+}
+
+func (fti fisherTransformIndicator) transform(index int, previous big.Decimal) big.Decimal {
+	x := fti.value.Calculate(index).Float()
+	fisher := 0.5 * math.Log((1+x)/(1-x))
+
+	return big.NewDecimal(fisher).Add(previous.Frac(0.5))
+}
+
+func (fti fisherTransformIndicator) cache() resultCache { return fti.resultCache }
+
+func (fti *fisherTransformIndicator) setCache(newCache resultCache) {
+	fti.resultCache = newCache
+}
+
+func (fti fisherTransformIndicator) windowSize() int { return 1 }
+
+// fisherValueIndicator returns the smoothed, normalized median price that the Fisher transform is applied to.
+type fisherValueIndicator struct {
+	median      Indicator
+	minValue    Indicator
+	maxValue    Indicator
+	resultCache resultCache
+}
+
+func (fvi *fisherValueIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(fvi, index, func(i int) big.Decimal {
+		return fvi.smooth(i, big.ZERO)
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	result := fvi.smooth(index, fvi.Calculate(index-1))
+	cacheResult(fvi, index, result)
+
+	return result
+}
+
+func (fvi fisherValueIndicator) smooth(index int, previous big.Decimal) big.Decimal {
+	minVal := fvi.minValue.Calculate(index)
+	maxVal := fvi.maxValue.Calculate(index)
+
+	position := SafeDivide(fvi.median.Calculate(index).Sub(minVal), maxVal.Sub(minVal), big.NewDecimal(0.5))
+	value := position.Sub(big.NewDecimal(0.5)).Frac(0.66).Add(previous.Frac(0.67))
+
+	limit := big.NewDecimal(0.999)
+	return big.MaxSlice(big.MinSlice(value, limit), limit.Neg())
+}
+
+func (fvi fisherValueIndicator) cache() resultCache { return fvi.resultCache }
+
+func (fvi *fisherValueIndicator) setCache(newCache resultCache) {
+	fvi.resultCache = newCache
+}
+
+func (fvi fisherValueIndicator) windowSize() int { return 1 }
@@ -0,0 +1,32 @@
+package techan
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFisherTransformIndicator(t *testing.T) {
+	series := mockTimeSeriesFl(10, 11, 12, 11, 13, 14, 15, 14, 16, 17, 16, 16, 16)
+
+	fisher := NewFisherTransformIndicator(series, 3)
+
+	expected := []float64{0, 0.3428, 0.7914, 0.4349, 0.5901, 0.9407, 1.3596, 0.8273, 0.8712, 1.1554, 0.6612, 0.0492, -0.1612}
+
+	indicatorEquals(t, expected, fisher)
+}
+
+func TestFisherTransformIndicatorClamp(t *testing.T) {
+	values := make([]float64, 30)
+	for i := range values {
+		values[i] = float64(i)
+	}
+
+	fisher := NewFisherTransformIndicator(mockTimeSeriesFl(values...), 3)
+
+	for i := range values {
+		value := fisher.Calculate(i).Float()
+		assert.False(t, math.IsNaN(value) || math.IsInf(value, 0))
+	}
+}
@@ -0,0 +1,41 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type forwardFillIndicator struct {
+	indicator Indicator
+	sentinel  big.Decimal
+}
+
+// NewForwardFillIndicator returns a derivative Indicator which fills gaps in the base indicator with its last valid
+// value. A value is missing when it is big.NaN or zero, as many indicators return one of them until they have enough
+// data; any other value is valid. Missing values are replaced by the most recent valid value at or before the index,
+// and returned as-is when there is none, e.g., during the base indicator's warm-up.
+func NewForwardFillIndicator(indicator Indicator) Indicator {
+	return NewForwardFillIndicatorWithSentinel(indicator, 0)
+}
+
+// NewForwardFillIndicatorWithSentinel returns a derivative Indicator which fills gaps in the base indicator like
+// NewForwardFillIndicator, treating values equal to sentinel, rather than zero, as missing. big.NaN is always treated
+// as missing.
+func NewForwardFillIndicatorWithSentinel(indicator Indicator, sentinel float64) Indicator {
+	return forwardFillIndicator{
+		indicator: indicator,
+		sentinel:  big.NewDecimal(sentinel),
+	}
+}
+
+func (ffi forwardFillIndicator) Calculate(index int) big.Decimal {
+	value := ffi.indicator.Calculate(index)
+	for i := index - 1; i >= 0 && ffi.missing(value); i-- {
+		if previous := ffi.indicator.Calculate(i); !ffi.missing(previous) {
+			return previous
+		}
+	}
+
+	return value
+}
+
+func (ffi forwardFillIndicator) missing(value big.Decimal) bool {
+	return value.NaN() || value.EQ(ffi.sentinel)
+}
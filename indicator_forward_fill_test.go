@@ -0,0 +1,36 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardFillIndicator(t *testing.T) {
+	t.Run("fills zero and NaN with last valid value", func(t *testing.T) {
+		base := NewTransformIndicator(NewFixedIndicator(3, 0, -1, 5, -1, 0), func(d big.Decimal) big.Decimal {
+			if d.EQ(big.ONE.Neg()) {
+				return big.NaN
+			}
+			return d
+		})
+
+		indicatorEquals(t, []float64{3, 3, 3, 5, 5, 5}, NewForwardFillIndicator(base))
+	})
+
+	t.Run("returns missing values before the first valid value", func(t *testing.T) {
+		ffi := NewForwardFillIndicator(NewFixedIndicator(0, 0, 2))
+
+		decimalEquals(t, 0, ffi.Calculate(0))
+		decimalEquals(t, 0, ffi.Calculate(1))
+		decimalEquals(t, 2, ffi.Calculate(2))
+	})
+
+	t.Run("uses a custom sentinel", func(t *testing.T) {
+		ffi := NewForwardFillIndicatorWithSentinel(NewFixedIndicator(-100, 4, 0, -100), -100)
+
+		assert.EqualValues(t, -100, ffi.Calculate(0).Float())
+		indicatorEquals(t, []float64{-100, 4, 0, 0}, ffi)
+	})
+}
@@ -0,0 +1,78 @@
+package techan
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sdcoffey/big"
+)
+
+type gaussianFilterIndicator struct {
+	indicator    Indicator
+	poles        int
+	gain         big.Decimal
+	coefficients []big.Decimal
+	resultCache  resultCache
+}
+
+// NewGaussianFilterIndicator returns a derivative Indicator which returns John Ehlers' Gaussian filter of the base
+// indicator, a low-lag smoothing filter. window is the critical period of the filter: cycles shorter than window are
+// smoothed out. The filter chains poles (1 through 4) single-pole filters, each of which is an EMA-like recursion: more
+// poles reject noise more sharply, but add lag, while a single pole behaves like an EMA. For the first poles values, the
+// base indicator's values are returned as-is to seed the recursion. NewGaussianFilterIndicator panics if poles is not
+// between 1 and 4.
+func NewGaussianFilterIndicator(indicator Indicator, window, poles int) Indicator {
+	if poles < 1 || poles > 4 {
+		panic(fmt.Errorf("error creating gaussian filter: poles must be between 1 and 4, got %d", poles))
+	}
+
+	beta := (1 - math.Cos(2*math.Pi/float64(window))) / (math.Pow(2, 1/float64(poles)) - 1)
+	alpha := -beta + math.Sqrt(beta*beta+2*beta)
+
+	// f[i] = alpha^N * x[i] + sum over k of (-1)^(k+1) * C(N, k) * (1 - alpha)^k * f[i-k]
+	coefficients := make([]big.Decimal, poles)
+	binomial := 1.0
+	for k := 1; k <= poles; k++ {
+		binomial = binomial * float64(poles-k+1) / float64(k)
+		coefficients[k-1] = big.NewDecimal(math.Pow(-1, float64(k+1)) * binomial * math.Pow(1-alpha, float64(k)))
+	}
+
+	return &gaussianFilterIndicator{
+		indicator:    indicator,
+		poles:        poles,
+		gain:         big.NewDecimal(math.Pow(alpha, float64(poles))),
+		coefficients: coefficients,
+		resultCache:  make([]*big.Decimal, 1000),
+	}
+}
+
+func (gfi *gaussianFilterIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(gfi, index, func(i int) big.Decimal {
+		return gfi.indicator.Calculate(i)
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	if index < gfi.poles {
+		result := gfi.indicator.Calculate(index)
+		cacheResult(gfi, index, result)
+		return result
+	}
+
+	result := gfi.gain.Mul(gfi.indicator.Calculate(index))
+	for k, coefficient := range gfi.coefficients {
+		result = result.Add(coefficient.Mul(gfi.Calculate(index - k - 1)))
+	}
+
+	cacheResult(gfi, index, result)
+
+	return result
+}
+
+func (gfi gaussianFilterIndicator) cache() resultCache { return gfi.resultCache }
+
+func (gfi *gaussianFilterIndicator) setCache(newCache resultCache) {
+	gfi.resultCache = newCache
+}
+
+func (gfi gaussianFilterIndicator) windowSize() int { return 1 }
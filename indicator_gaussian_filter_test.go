@@ -0,0 +1,34 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGaussianFilterIndicator(t *testing.T) {
+	src := NewFixedIndicator(0, 0, 10, 10, 10)
+
+	t.Run("one pole", func(t *testing.T) {
+		indicatorEquals(t, []float64{0, 0, 7.3205, 9.282, 9.8076}, NewGaussianFilterIndicator(src, 4, 1))
+	})
+
+	t.Run("two poles", func(t *testing.T) {
+		indicatorEquals(t, []float64{0, 0, 7.2296, 9.3946, 9.8808}, NewGaussianFilterIndicator(src, 4, 2))
+	})
+
+	t.Run("preserves a constant", func(t *testing.T) {
+		gfi := NewGaussianFilterIndicator(NewConstantIndicator(5), 10, 4)
+
+		decimalEquals(t, 5, gfi.Calculate(20))
+	})
+
+	t.Run("panics with invalid poles", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewGaussianFilterIndicator(src, 4, 0)
+		})
+		assert.Panics(t, func() {
+			NewGaussianFilterIndicator(src, 4, 5)
+		})
+	})
+}
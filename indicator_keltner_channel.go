@@ -15,7 +15,7 @@ func NewKeltnerChannelUpperIndicator(series *TimeSeries, window int) Indicator {
 	return keltnerChannelIndicator{
 		atr:    NewAverageTrueRangeIndicator(series, window),
 		ema:    NewEMAIndicator(NewClosePriceIndicator(series), window),
-		mul:    big.ONE,
+		mul:    big.NewFromInt(2),
 		window: window,
 	}
 }
@@ -24,7 +24,7 @@ func NewKeltnerChannelLowerIndicator(series *TimeSeries, window int) Indicator {
 	return keltnerChannelIndicator{
 		atr:    NewAverageTrueRangeIndicator(series, window),
 		ema:    NewEMAIndicator(NewClosePriceIndicator(series), window),
-		mul:    big.ONE.Neg(),
+		mul:    big.NewFromInt(-2),
 		window: window,
 	}
 }
@@ -34,7 +34,5 @@ func (kci keltnerChannelIndicator) Calculate(index int) big.Decimal {
 		return big.ZERO
 	}
 
-	coefficient := big.NewFromInt(2).Mul(kci.mul)
-
-	return kci.ema.Calculate(index).Add(kci.atr.Calculate(index).Mul(coefficient))
+	return kci.ema.Calculate(index).Add(kci.atr.Calculate(index).Mul(kci.mul))
 }
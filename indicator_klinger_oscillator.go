@@ -0,0 +1,98 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+// NewKlingerOscillatorIndicator returns a derivative Indicator which returns the Klinger volume oscillator, the
+// difference between a fastWindow and a slowWindow EMA of the volume force of the series. Volume force weights each
+// candle's volume by the direction of the trend (as measured by the sum of the high, low, and close prices) and by the
+// ratio of the candle's range to the cumulative range since the trend last changed direction.
+// https://www.investopedia.com/terms/k/klingeroscillator.asp
+func NewKlingerOscillatorIndicator(series *TimeSeries, fastWindow, slowWindow int) Indicator {
+	volumeForce := volumeForceIndicator{
+		series: series,
+		cm: &cumulativeMeasurementIndicator{
+			series:      series,
+			resultCache: make([]*big.Decimal, 1000),
+		},
+	}
+
+	return NewDifferenceIndicator(NewEMAIndicator(volumeForce, fastWindow), NewEMAIndicator(volumeForce, slowWindow))
+}
+
+// NewKlingerSignalLine returns an Indicator intended to be used in conjunction with the Klinger oscillator, which
+// returns the window EMA of the oscillator. A window of 13 is typical.
+func NewKlingerSignalLine(klinger Indicator, window int) Indicator {
+	return NewEMAIndicator(klinger, window)
+}
+
+type volumeForceIndicator struct {
+	series *TimeSeries
+	cm     Indicator
+}
+
+func (vfi volumeForceIndicator) Calculate(index int) big.Decimal {
+	if index == 0 {
+		return big.ZERO
+	}
+
+	dm := candleRange(vfi.series, index)
+	magnitude := big.NewFromInt(2).Mul(SafeDivide(dm, vfi.cm.Calculate(index), big.ONE).Sub(big.ONE)).Abs()
+
+	return vfi.series.Candles[index].Volume.Mul(magnitude).Mul(big.NewFromInt(klingerTrend(vfi.series, index))).Mul(big.NewFromInt(100))
+}
+
+// cumulativeMeasurementIndicator returns the sum of the candle ranges since the Klinger trend last changed direction.
+// Because each value depends on the one before it, results are cached.
+type cumulativeMeasurementIndicator struct {
+	series      *TimeSeries
+	resultCache resultCache
+}
+
+func (cmi *cumulativeMeasurementIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(cmi, index, func(i int) big.Decimal {
+		return candleRange(cmi.series, i)
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	var result big.Decimal
+	if klingerTrend(cmi.series, index) == klingerTrend(cmi.series, index-1) {
+		result = cmi.Calculate(index - 1).Add(candleRange(cmi.series, index))
+	} else {
+		result = candleRange(cmi.series, index-1).Add(candleRange(cmi.series, index))
+	}
+
+	cacheResult(cmi, index, result)
+
+	return result
+}
+
+func (cmi cumulativeMeasurementIndicator) cache() resultCache { return cmi.resultCache }
+
+func (cmi *cumulativeMeasurementIndicator) setCache(newCache resultCache) {
+	cmi.resultCache = newCache
+}
+
+func (cmi cumulativeMeasurementIndicator) windowSize() int { return 1 }
+
+// klingerTrend returns 1 if the sum of the high, low, and close prices rose from the previous candle, -1 if it did not,
+// and 0 at the first index.
+func klingerTrend(series *TimeSeries, index int) int {
+	if index == 0 {
+		return 0
+	}
+
+	sum := func(c *Candle) big.Decimal {
+		return c.MaxPrice.Add(c.MinPrice).Add(c.ClosePrice)
+	}
+
+	if sum(series.Candles[index]).GT(sum(series.Candles[index-1])) {
+		return 1
+	}
+
+	return -1
+}
+
+func candleRange(series *TimeSeries, index int) big.Decimal {
+	return series.Candles[index].MaxPrice.Sub(series.Candles[index].MinPrice)
+}
@@ -0,0 +1,32 @@
+package techan
+
+import "testing"
+
+func klingerSeries() *TimeSeries {
+	return mockTimeSeriesOCHL(
+		[]float64{10, 12, 12, 8},
+		[]float64{11, 14, 14, 9},
+		[]float64{10, 20, 24, 10},
+		[]float64{9, 10, 11, 9},
+		[]float64{11, 14, 14, 9},
+		[]float64{9, 10, 11, 9},
+		[]float64{10, 12, 12, 10},
+		[]float64{9, 10, 11, 8},
+	)
+}
+
+func TestKlingerOscillatorIndicator(t *testing.T) {
+	kvo := NewKlingerOscillatorIndicator(klingerSeries(), 2, 3)
+
+	expected := []float64{0, 44.4444, 37.3591, -88.6809, 45.468, -104.4727, 124.409, -72.247}
+
+	indicatorEquals(t, expected, kvo)
+}
+
+func TestKlingerSignalLine(t *testing.T) {
+	signal := NewKlingerSignalLine(NewKlingerOscillatorIndicator(klingerSeries(), 2, 3), 2)
+
+	expected := []float64{0, 22.2222, 32.3135, -48.3494, 14.1955, -64.9166, 61.3005, -27.7312}
+
+	indicatorEquals(t, expected, signal)
+}
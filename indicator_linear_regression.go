@@ -0,0 +1,90 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type linearRegressionIndicator struct {
+	indicator Indicator
+	window    int
+}
+
+// NewLinearRegressionIndicator returns a derivative Indicator which returns the value at the current index of the least
+// squares line fitted through the values of the base indicator over the trailing window. Before a full window is
+// available, the line is fitted through the available values.
+// https://www.investopedia.com/terms/l/linearregression.asp
+func NewLinearRegressionIndicator(indicator Indicator, window int) Indicator {
+	return linearRegressionIndicator{
+		indicator: indicator,
+		window:    window,
+	}
+}
+
+func (lri linearRegressionIndicator) Calculate(index int) big.Decimal {
+	line, _ := lri.fit(index)
+	return line
+}
+
+// fit returns the value of the regression line at index, along with the standard deviation of the base indicator's
+// residuals from the line over the window.
+func (lri linearRegressionIndicator) fit(index int) (line, residualStdDev big.Decimal) {
+	start := Max(index-lri.window+1, 0)
+
+	values := make([]big.Decimal, index-start+1)
+	for i := range values {
+		values[i] = lri.indicator.Calculate(start + i)
+	}
+
+	slope, intercept := trendLineFit(values)
+	residuals := residualIndicator{
+		indicator: lri.indicator,
+		start:     start,
+		slope:     slope,
+		intercept: intercept,
+	}
+
+	line = intercept.Add(slope.Mul(big.NewFromInt(index - start)))
+	return line, NewWindowedVarianceIndicator(residuals, lri.window).Calculate(index).Sqrt()
+}
+
+// residualIndicator returns the distance of the base indicator from a line fitted from start. The residuals of a least
+// squares fit average zero, so their variance is the mean squared residual.
+type residualIndicator struct {
+	indicator Indicator
+	start     int
+	slope     big.Decimal
+	intercept big.Decimal
+}
+
+func (ri residualIndicator) Calculate(index int) big.Decimal {
+	line := ri.intercept.Add(ri.slope.Mul(big.NewFromInt(index - ri.start)))
+	return ri.indicator.Calculate(index).Sub(line)
+}
+
+type regressionChannelIndicator struct {
+	regression linearRegressionIndicator
+	muladd     big.Decimal
+}
+
+// NewRegressionChannelUpperIndicator returns a derivative indicator which returns the upper bound of a regression
+// channel on the underlying indicator: the linear regression line over window, as returned by
+// NewLinearRegressionIndicator, plus k times the standard deviation of the indicator's residuals from the line.
+func NewRegressionChannelUpperIndicator(indicator Indicator, window int, k float64) Indicator {
+	return regressionChannelIndicator{
+		regression: linearRegressionIndicator{indicator: indicator, window: window},
+		muladd:     big.NewDecimal(k),
+	}
+}
+
+// NewRegressionChannelLowerIndicator returns a derivative indicator which returns the lower bound of a regression
+// channel on the underlying indicator: the linear regression line over window, as returned by
+// NewLinearRegressionIndicator, minus k times the standard deviation of the indicator's residuals from the line.
+func NewRegressionChannelLowerIndicator(indicator Indicator, window int, k float64) Indicator {
+	return regressionChannelIndicator{
+		regression: linearRegressionIndicator{indicator: indicator, window: window},
+		muladd:     big.NewDecimal(-k),
+	}
+}
+
+func (rci regressionChannelIndicator) Calculate(index int) big.Decimal {
+	line, residualStdDev := rci.regression.fit(index)
+	return line.Add(residualStdDev.Mul(rci.muladd))
+}
@@ -0,0 +1,39 @@
+package techan
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearRegressionIndicator(t *testing.T) {
+	t.Run("fits a straight line exactly", func(t *testing.T) {
+		lri := NewLinearRegressionIndicator(NewFixedIndicator(1, 3, 5, 7, 9), 3)
+
+		indicatorEquals(t, []float64{1, 3, 5, 7, 9}, lri)
+	})
+
+	t.Run("returns the end of the fitted line", func(t *testing.T) {
+		// Over 1, 3, 2 the line is y = 1.5 + 0.5x
+		lri := NewLinearRegressionIndicator(NewFixedIndicator(5, 1, 3, 2), 3)
+
+		decimalEquals(t, 2.5, lri.Calculate(3))
+	})
+}
+
+func TestRegressionChannelIndicator(t *testing.T) {
+	// Over 1, 3, 2 the line is y = 1.5 + 0.5x, with residuals of -0.5, 1 and -0.5
+	src := NewFixedIndicator(5, 1, 3, 2)
+
+	upper := NewRegressionChannelUpperIndicator(src, 3, 2)
+	lower := NewRegressionChannelLowerIndicator(src, 3, 2)
+
+	decimalEquals(t, 2.5+2*math.Sqrt(0.5), upper.Calculate(3))
+	decimalEquals(t, 2.5-2*math.Sqrt(0.5), lower.Calculate(3))
+
+	t.Run("collapses onto a straight line", func(t *testing.T) {
+		line := NewFixedIndicator(1, 3, 5, 7)
+
+		decimalEquals(t, 7, NewRegressionChannelUpperIndicator(line, 3, 2).Calculate(3))
+		decimalEquals(t, 7, NewRegressionChannelLowerIndicator(line, 3, 2).Calculate(3))
+	})
+}
@@ -17,4 +17,10 @@ func TestMaximumValueIndicator(t *testing.T) {
 		mvi := NewMaximumValueIndicator(NewClosePriceIndicator(ts), -1)
 		decimalEquals(t, 20, mvi.Calculate(ts.LastIndex()))
 	})
+
+	t.Run("early bars use available values", func(t *testing.T) {
+		mvi := NewMaximumValueIndicator(NewFixedIndicator(5, 3, 7, 6, 1, 4), 3)
+
+		indicatorEquals(t, []float64{5, 5, 7, 7, 7, 6}, mvi)
+	})
 }
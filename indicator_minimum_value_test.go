@@ -17,4 +17,10 @@ func TestMinimumValueIndicator(t *testing.T) {
 		mvi := NewMinimumValueIndicator(NewClosePriceIndicator(ts), -1)
 		decimalEquals(t, -1, mvi.Calculate(ts.LastIndex()))
 	})
+
+	t.Run("early bars use available values", func(t *testing.T) {
+		mvi := NewMinimumValueIndicator(NewFixedIndicator(5, 3, 7, 6, 1, 4), 3)
+
+		indicatorEquals(t, []float64{5, 3, 3, 3, 1, 1}, mvi)
+	})
 }
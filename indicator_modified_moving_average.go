@@ -19,6 +19,14 @@ func NewMMAIndicator(indicator Indicator, window int) Indicator {
 	}
 }
 
+// NewSmoothedMovingAverageIndicator returns a derivative indicator which returns the smoothed moving average (SMMA,
+// also known as RMA or Wilder's smoothing) of the underlying indicator: the previous value times (window-1)/window plus
+// the current value divided by window, seeded with the simple moving average of the first window values. This is the
+// same calculation as the modified moving average, and the result is cached in the same way.
+func NewSmoothedMovingAverageIndicator(indicator Indicator, window int) Indicator {
+	return NewMMAIndicator(indicator, window)
+}
+
 func (mma *modifiedMovingAverageIndicator) Calculate(index int) big.Decimal {
 	if cachedValue := returnIfCached(mma, index, func(i int) big.Decimal {
 		return NewSimpleMovingAverage(mma.indicator, mma.window).Calculate(i)
@@ -24,3 +24,18 @@ func TestModifiedMovingAverage(t *testing.T) {
 
 	indicatorEquals(t, expected, indicator)
 }
+
+func TestSmoothedMovingAverage(t *testing.T) {
+	indicator := NewSmoothedMovingAverageIndicator(NewFixedIndicator(1, 2, 3, 6, 3, 0), 3)
+
+	expected := []float64{
+		0,
+		0,
+		2,
+		3.3333,
+		3.2222,
+		2.1481,
+	}
+
+	indicatorEquals(t, expected, indicator)
+}
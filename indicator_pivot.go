@@ -0,0 +1,102 @@
+package techan
+
+import (
+	"fmt"
+
+	"github.com/sdcoffey/big"
+)
+
+type pivotIndicator struct {
+	series      *TimeSeries
+	leftBars    int
+	rightBars   int
+	high        bool
+	resultCache resultCache
+}
+
+// NewPivotHighIndicator returns a derivative Indicator which returns the high price of the most recently confirmed
+// pivot high: a candle whose high is strictly higher than the highs of the leftBars candles before it and the
+// rightBars candles after it. A pivot is only confirmed once its rightBars later candles exist, so the value at index is
+// the most recent pivot high no later than index - rightBars, and never looks ahead. Before the first pivot is
+// confirmed, the value is big.NaN. NewPivotHighIndicator panics if leftBars or rightBars is negative.
+func NewPivotHighIndicator(series *TimeSeries, leftBars, rightBars int) Indicator {
+	return newPivotIndicator(series, leftBars, rightBars, true)
+}
+
+// NewPivotLowIndicator returns a derivative Indicator which returns the low price of the most recently confirmed pivot
+// low: a candle whose low is strictly lower than the lows of the leftBars candles before it and the rightBars candles
+// after it. Pivots are confirmed as in NewPivotHighIndicator. Before the first pivot is confirmed, the value is
+// big.NaN. NewPivotLowIndicator panics if leftBars or rightBars is negative.
+func NewPivotLowIndicator(series *TimeSeries, leftBars, rightBars int) Indicator {
+	return newPivotIndicator(series, leftBars, rightBars, false)
+}
+
+func newPivotIndicator(series *TimeSeries, leftBars, rightBars int, high bool) Indicator {
+	if leftBars < 0 || rightBars < 0 {
+		panic(fmt.Errorf("error creating pivot indicator: bars must not be negative, got %d left and %d right", leftBars, rightBars))
+	}
+
+	return &pivotIndicator{
+		series:      series,
+		leftBars:    leftBars,
+		rightBars:   rightBars,
+		high:        high,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+func (pi *pivotIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(pi, index, func(i int) big.Decimal {
+		if pi.isPivot(i - pi.rightBars) {
+			return pi.price(i - pi.rightBars)
+		}
+
+		return big.NaN
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	result := pi.Calculate(index - 1)
+	if candidate := index - pi.rightBars; pi.isPivot(candidate) {
+		result = pi.price(candidate)
+	}
+
+	cacheResult(pi, index, result)
+
+	return result
+}
+
+func (pi pivotIndicator) isPivot(index int) bool {
+	if index-pi.leftBars < 0 {
+		return false
+	}
+
+	price := pi.price(index)
+	for i := index - pi.leftBars; i <= index+pi.rightBars; i++ {
+		if i == index {
+			continue
+		}
+
+		if (pi.high && !pi.price(i).LT(price)) || (!pi.high && !pi.price(i).GT(price)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (pi pivotIndicator) price(index int) big.Decimal {
+	if pi.high {
+		return pi.series.Candles[index].MaxPrice
+	}
+
+	return pi.series.Candles[index].MinPrice
+}
+
+func (pi pivotIndicator) cache() resultCache { return pi.resultCache }
+
+func (pi *pivotIndicator) setCache(newCache resultCache) {
+	pi.resultCache = newCache
+}
+
+func (pi pivotIndicator) windowSize() int { return 1 }
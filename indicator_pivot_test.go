@@ -0,0 +1,45 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPivotHighIndicator(t *testing.T) {
+	// Highs are close + 1
+	series := mockTimeSeriesFl(1, 3, 2, 5, 4, 4, 6, 2)
+	phi := NewPivotHighIndicator(series, 1, 1)
+
+	t.Run("returns NaN before the first pivot is confirmed", func(t *testing.T) {
+		assert.True(t, phi.Calculate(0).NaN())
+		assert.True(t, phi.Calculate(1).NaN())
+	})
+
+	t.Run("returns the most recently confirmed pivot", func(t *testing.T) {
+		expected := []float64{4, 4, 6, 6, 6, 7}
+		for i, want := range expected {
+			decimalEquals(t, want, phi.Calculate(i+2))
+		}
+	})
+
+	t.Run("panics with negative bars", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewPivotHighIndicator(series, -1, 1)
+		})
+	})
+}
+
+func TestPivotLowIndicator(t *testing.T) {
+	// Lows are close - 1; equal lows at indices 4 and 5 are not pivots
+	series := mockTimeSeriesFl(1, 3, 2, 5, 4, 4, 6, 2)
+	pli := NewPivotLowIndicator(series, 1, 1)
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, pli.Calculate(i).NaN())
+	}
+
+	for i := 3; i < len(series.Candles); i++ {
+		decimalEquals(t, 1, pli.Calculate(i))
+	}
+}
@@ -0,0 +1,44 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type priceVolumeTrendIndicator struct {
+	series      *TimeSeries
+	resultCache resultCache
+}
+
+// NewPriceVolumeTrendIndicator returns an Indicator which returns the price volume trend of the series, the running
+// total of each candle's volume multiplied by the percentage change in close price from the previous candle. The first
+// candle contributes zero.
+// https://www.investopedia.com/terms/p/pvtrend.asp
+func NewPriceVolumeTrendIndicator(series *TimeSeries) Indicator {
+	return &priceVolumeTrendIndicator{
+		series:      series,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+func (pvt *priceVolumeTrendIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(pvt, index, func(i int) big.Decimal {
+		return big.ZERO
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	prevClose := pvt.series.Candles[index-1].ClosePrice
+	candle := pvt.series.Candles[index]
+	change := SafeDivide(candle.ClosePrice.Sub(prevClose), prevClose, big.ZERO)
+
+	result := pvt.Calculate(index - 1).Add(candle.Volume.Mul(change))
+	cacheResult(pvt, index, result)
+
+	return result
+}
+
+func (pvt priceVolumeTrendIndicator) cache() resultCache { return pvt.resultCache }
+
+func (pvt *priceVolumeTrendIndicator) setCache(newCache resultCache) {
+	pvt.resultCache = newCache
+}
+
+func (pvt priceVolumeTrendIndicator) windowSize() int { return 1 }
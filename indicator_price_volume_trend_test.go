@@ -0,0 +1,17 @@
+package techan
+
+import "testing"
+
+func TestPriceVolumeTrendIndicator(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 10, 12, 8},
+		[]float64{10, 12, 14, 9},
+		[]float64{12, 9, 13, 8},
+		[]float64{9, 9, 10, 8},
+		[]float64{9, 18, 18, 9},
+	)
+
+	pvt := NewPriceVolumeTrendIndicator(series)
+
+	indicatorEquals(t, []float64{0, 0.2, -0.3, -0.3, 3.7}, pvt)
+}
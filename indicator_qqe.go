@@ -0,0 +1,185 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+// NewQQEIndicator returns a derivative Indicator which returns the distance of the QQE (quantitative qualitative
+// estimation) line above its adaptive trailing level, as returned by NewQQELineIndicator and
+// NewQQETrailingLevelIndicator. The value turns positive when the QQE line crosses above its trailing level, and
+// negative when it crosses below it, which are the usual QQE signals.
+// https://www.tradingview.com/script/tJ6vtBBe-QQE/
+func NewQQEIndicator(series *TimeSeries, rsiWindow, smoothing int, factor float64) Indicator {
+	return NewDifferenceIndicator(
+		NewQQELineIndicator(series, rsiWindow, smoothing),
+		NewQQETrailingLevelIndicator(series, rsiWindow, smoothing, factor),
+	)
+}
+
+// NewQQELineIndicator returns a derivative Indicator which returns the QQE line of the series: the relative strength
+// index of the close price over rsiWindow, smoothed by an EMA over smoothing.
+func NewQQELineIndicator(series *TimeSeries, rsiWindow, smoothing int) Indicator {
+	return NewEMAIndicator(NewRelativeStrengthIndexIndicator(NewClosePriceIndicator(series), rsiWindow), smoothing)
+}
+
+// NewQQETrailingLevelIndicator returns a derivative Indicator which returns the adaptive trailing level of the QQE line
+// built from the same rsiWindow and smoothing. The level trails below the QQE line while it is trending up, and above
+// it while it is trending down, at a distance of factor times the doubly smoothed average change of the QQE line (the
+// "ATR" of the RSI), smoothed over 2*rsiWindow-1. The trend turns up when the QQE line rises above the previous upper
+// level, and down when it falls below the previous lower level. A factor of 4.236 is common.
+func NewQQETrailingLevelIndicator(series *TimeSeries, rsiWindow, smoothing int, factor float64) Indicator {
+	line := NewQQELineIndicator(series, rsiWindow, smoothing)
+	wildersWindow := 2*rsiWindow - 1
+
+	atrRsi := NewEMAIndicator(NewEMAIndicator(absoluteChangeIndicator{line}, wildersWindow), wildersWindow)
+	distance := constantMultipleIndicator{atrRsi, big.NewDecimal(factor)}
+
+	longBand := newQQEBandIndicator(line, distance, -1)
+	shortBand := newQQEBandIndicator(line, distance, 1)
+
+	return qqeTrailingLevelIndicator{
+		trend:     newQQETrendIndicator(line, longBand, shortBand),
+		longBand:  longBand,
+		shortBand: shortBand,
+	}
+}
+
+type qqeTrailingLevelIndicator struct {
+	trend     Indicator
+	longBand  Indicator
+	shortBand Indicator
+}
+
+func (qtl qqeTrailingLevelIndicator) Calculate(index int) big.Decimal {
+	if qtl.trend.Calculate(index).GT(big.ZERO) {
+		return qtl.longBand.Calculate(index)
+	}
+
+	return qtl.shortBand.Calculate(index)
+}
+
+// qqeBandIndicator ratchets a band at distance from the QQE line: a long band (side -1) sits below the line and only
+// rises while the line stays above it, and a short band (side 1) sits above the line and only falls while the line
+// stays below it. Once the line crosses the band, the band resets to the line plus or minus the distance.
+type qqeBandIndicator struct {
+	line        Indicator
+	distance    Indicator
+	side        int
+	resultCache resultCache
+}
+
+func newQQEBandIndicator(line, distance Indicator, side int) *qqeBandIndicator {
+	return &qqeBandIndicator{
+		line:        line,
+		distance:    distance,
+		side:        side,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+func (qbi *qqeBandIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(qbi, index, qbi.newBand); cachedValue != nil {
+		return *cachedValue
+	}
+
+	prevBand := qbi.Calculate(index - 1)
+	result := qbi.newBand(index)
+
+	// The line stayed on the far side of the band, so keep trailing it
+	if qbi.line.Calculate(index-1).Cmp(prevBand) == -qbi.side && qbi.line.Calculate(index).Cmp(prevBand) == -qbi.side {
+		if qbi.side < 0 {
+			result = big.MaxSlice(prevBand, result)
+		} else {
+			result = big.MinSlice(prevBand, result)
+		}
+	}
+
+	cacheResult(qbi, index, result)
+
+	return result
+}
+
+func (qbi *qqeBandIndicator) newBand(index int) big.Decimal {
+	distance := qbi.distance.Calculate(index)
+	if qbi.side < 0 {
+		distance = distance.Neg()
+	}
+
+	return qbi.line.Calculate(index).Add(distance)
+}
+
+func (qbi qqeBandIndicator) cache() resultCache { return qbi.resultCache }
+
+func (qbi *qqeBandIndicator) setCache(newCache resultCache) {
+	qbi.resultCache = newCache
+}
+
+func (qbi qqeBandIndicator) windowSize() int { return 1 }
+
+// qqeTrendIndicator returns 1 while the QQE line is trending up and -1 while it is trending down
+type qqeTrendIndicator struct {
+	line        Indicator
+	longBand    Indicator
+	shortBand   Indicator
+	resultCache resultCache
+}
+
+func newQQETrendIndicator(line, longBand, shortBand Indicator) *qqeTrendIndicator {
+	return &qqeTrendIndicator{
+		line:        line,
+		longBand:    longBand,
+		shortBand:   shortBand,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+func (qti *qqeTrendIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(qti, index, func(i int) big.Decimal {
+		return big.ONE
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	line := qti.line.Calculate(index)
+
+	var result big.Decimal
+	if line.GT(qti.shortBand.Calculate(index - 1)) {
+		result = big.ONE
+	} else if line.LT(qti.longBand.Calculate(index - 1)) {
+		result = big.ONE.Neg()
+	} else {
+		result = qti.Calculate(index - 1)
+	}
+
+	cacheResult(qti, index, result)
+
+	return result
+}
+
+func (qti qqeTrendIndicator) cache() resultCache { return qti.resultCache }
+
+func (qti *qqeTrendIndicator) setCache(newCache resultCache) {
+	qti.resultCache = newCache
+}
+
+func (qti qqeTrendIndicator) windowSize() int { return 1 }
+
+// absoluteChangeIndicator returns the magnitude of the change in the underlying indicator from the previous index
+type absoluteChangeIndicator struct {
+	indicator Indicator
+}
+
+func (aci absoluteChangeIndicator) Calculate(index int) big.Decimal {
+	if index == 0 {
+		return big.ZERO
+	}
+
+	return aci.indicator.Calculate(index).Sub(aci.indicator.Calculate(index - 1)).Abs()
+}
+
+type constantMultipleIndicator struct {
+	indicator Indicator
+	factor    big.Decimal
+}
+
+func (cmi constantMultipleIndicator) Calculate(index int) big.Decimal {
+	return cmi.indicator.Calculate(index).Mul(cmi.factor)
+}
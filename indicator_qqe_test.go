@@ -0,0 +1,54 @@
+package techan
+
+import "testing"
+
+func TestQQETrailingLevel(t *testing.T) {
+	line := NewFixedIndicator(50, 55, 60, 52, 45, 50)
+	distance := NewConstantIndicator(5)
+
+	longBand := newQQEBandIndicator(line, distance, -1)
+	shortBand := newQQEBandIndicator(line, distance, 1)
+
+	t.Run("long band", func(t *testing.T) {
+		indicatorEquals(t, []float64{45, 50, 55, 47, 40, 45}, newQQEBandIndicator(line, distance, -1))
+	})
+
+	t.Run("short band", func(t *testing.T) {
+		indicatorEquals(t, []float64{55, 60, 65, 57, 50, 55}, newQQEBandIndicator(line, distance, 1))
+	})
+
+	t.Run("trailing level", func(t *testing.T) {
+		level := qqeTrailingLevelIndicator{
+			trend:     newQQETrendIndicator(line, longBand, shortBand),
+			longBand:  longBand,
+			shortBand: shortBand,
+		}
+
+		indicatorEquals(t, []float64{45, 50, 55, 57, 50, 55}, level)
+	})
+}
+
+func TestQQEIndicator(t *testing.T) {
+	series := mockTimeSeriesFl(10, 11, 12, 11, 13, 14, 15, 16, 15, 13, 12, 10, 9, 8, 9)
+
+	t.Run("line", func(t *testing.T) {
+		expected := []float64{0, 0, 66.6667, 60.3175, 74.2725, 82.6330, 88.1273, 91.8812, 73.0326, 45.9278, 31.0837,
+			19.0887, 12.9001, 9.0494, 25.7775}
+
+		indicatorEquals(t, expected, NewQQELineIndicator(series, 3, 2))
+	})
+
+	t.Run("trailing level", func(t *testing.T) {
+		expected := []float64{0, 0, 66.6667, 60.3175, 70.7937, 75.5194, 79.5783, 83.2269, 82.8663, 58.2047, 44.7318,
+			33.1625, 26.2870, 21.0713, 13.8392}
+
+		indicatorEquals(t, expected, NewQQETrailingLevelIndicator(series, 3, 2, 1))
+	})
+
+	t.Run("distance above trailing level", func(t *testing.T) {
+		expected := []float64{0, 0, 0, 0, 3.4788, 7.1135, 8.5491, 8.6543, -9.8337, -12.2769, -13.6481, -14.0738,
+			-13.3868, -12.0220, 11.9383}
+
+		indicatorEquals(t, expected, NewQQEIndicator(series, 3, 2, 1))
+	})
+}
@@ -0,0 +1,22 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type ratioIndicator struct {
+	numerator   Indicator
+	denominator Indicator
+}
+
+// NewRatioIndicator returns an indicator which returns the ratio of one indicator (numerator) to a second indicator
+// (denominator), such as the price ratio of two instruments in a pairs trade. When the denominator is zero, the ratio
+// is zero.
+func NewRatioIndicator(numerator, denominator Indicator) Indicator {
+	return ratioIndicator{
+		numerator:   numerator,
+		denominator: denominator,
+	}
+}
+
+func (ri ratioIndicator) Calculate(index int) big.Decimal {
+	return SafeDivide(ri.numerator.Calculate(index), ri.denominator.Calculate(index), big.ZERO)
+}
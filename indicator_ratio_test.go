@@ -0,0 +1,9 @@
+package techan
+
+import "testing"
+
+func TestRatioIndicator(t *testing.T) {
+	ri := NewRatioIndicator(NewFixedIndicator(10, 9, 12, 5), NewFixedIndicator(5, 3, 0, 10))
+
+	indicatorEquals(t, []float64{2, 3, 0, 0.5}, ri)
+}
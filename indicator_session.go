@@ -0,0 +1,125 @@
+package techan
+
+import (
+	"time"
+
+	"github.com/sdcoffey/big"
+)
+
+type sessionField int
+
+const (
+	sessionOpen sessionField = iota
+	sessionHigh
+	sessionLow
+	sessionPriorClose
+)
+
+type sessionIndicator struct {
+	series       *TimeSeries
+	sessionStart time.Duration
+	field        sessionField
+	resultCache  resultCache
+}
+
+// NewSessionOpenIndicator returns a derivative Indicator which returns the open price of the first candle of the
+// current session. Sessions begin every day at sessionStart, an offset from midnight in the location of each candle's
+// start time, e.g., 9*time.Hour + 30*time.Minute; a candle belongs to the session that most recently began at or before
+// its start.
+func NewSessionOpenIndicator(series *TimeSeries, sessionStart time.Duration) Indicator {
+	return newSessionIndicator(series, sessionStart, sessionOpen)
+}
+
+// NewSessionHighIndicator returns a derivative Indicator which returns the highest high of the current session so far,
+// up to and including the current candle. Sessions are defined as in NewSessionOpenIndicator.
+func NewSessionHighIndicator(series *TimeSeries, sessionStart time.Duration) Indicator {
+	return newSessionIndicator(series, sessionStart, sessionHigh)
+}
+
+// NewSessionLowIndicator returns a derivative Indicator which returns the lowest low of the current session so far, up
+// to and including the current candle. Sessions are defined as in NewSessionOpenIndicator.
+func NewSessionLowIndicator(series *TimeSeries, sessionStart time.Duration) Indicator {
+	return newSessionIndicator(series, sessionStart, sessionLow)
+}
+
+// NewPriorSessionCloseIndicator returns a derivative Indicator which returns the close price of the last candle before
+// the current session, or big.NaN during the first session of the series. Sessions are defined as in
+// NewSessionOpenIndicator.
+func NewPriorSessionCloseIndicator(series *TimeSeries, sessionStart time.Duration) Indicator {
+	return newSessionIndicator(series, sessionStart, sessionPriorClose)
+}
+
+func newSessionIndicator(series *TimeSeries, sessionStart time.Duration, field sessionField) Indicator {
+	return &sessionIndicator{
+		series:       series,
+		sessionStart: sessionStart,
+		field:        field,
+		resultCache:  make([]*big.Decimal, 1000),
+	}
+}
+
+func (si *sessionIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(si, index, si.sessionFirst); cachedValue != nil {
+		return *cachedValue
+	}
+
+	candle := si.series.Candles[index]
+	if !si.sessionOf(candle).Equal(si.sessionOf(si.series.Candles[index-1])) {
+		result := si.sessionFirst(index)
+		cacheResult(si, index, result)
+		return result
+	}
+
+	result := si.Calculate(index - 1)
+	switch si.field {
+	case sessionHigh:
+		result = big.MaxSlice(result, candle.MaxPrice)
+	case sessionLow:
+		result = big.MinSlice(result, candle.MinPrice)
+	}
+
+	cacheResult(si, index, result)
+
+	return result
+}
+
+// sessionFirst returns the value at index when the candle at index is the first of its session
+func (si *sessionIndicator) sessionFirst(index int) big.Decimal {
+	candle := si.series.Candles[index]
+
+	switch si.field {
+	case sessionHigh:
+		return candle.MaxPrice
+	case sessionLow:
+		return candle.MinPrice
+	case sessionPriorClose:
+		if index == 0 {
+			return big.NaN
+		}
+		return si.series.Candles[index-1].ClosePrice
+	default:
+		return candle.OpenPrice
+	}
+}
+
+// sessionOf returns the time at which the session containing the candle began
+func (si *sessionIndicator) sessionOf(candle *Candle) time.Time {
+	start := candle.Period.Start
+	year, month, day := start.Date()
+
+	// time.Date normalizes the offset as wall clock time, so sessions keep their local start across DST changes
+	begin := time.Date(year, month, day, 0, 0, 0, int(si.sessionStart), start.Location())
+	if start.Before(begin) {
+		begin = time.Date(year, month, day-1, 0, 0, 0, int(si.sessionStart), start.Location())
+	}
+
+	return begin
+}
+
+func (si sessionIndicator) cache() resultCache { return si.resultCache }
+
+func (si *sessionIndicator) setCache(newCache resultCache) {
+	si.resultCache = newCache
+}
+
+func (si sessionIndicator) windowSize() int { return 1 }
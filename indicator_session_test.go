@@ -0,0 +1,72 @@
+package techan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionIndicators(t *testing.T) {
+	series := NewTimeSeries()
+	for _, c := range []struct {
+		start time.Time
+		ochl  [4]float64
+	}{
+		{time.Date(2021, 3, 1, 8, 0, 0, 0, time.UTC), [4]float64{9, 10, 11, 8}},    // previous session
+		{time.Date(2021, 3, 1, 9, 30, 0, 0, time.UTC), [4]float64{10, 12, 13, 10}}, // session opens
+		{time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC), [4]float64{12, 11, 12, 9}},
+		{time.Date(2021, 3, 2, 9, 0, 0, 0, time.UTC), [4]float64{11, 14, 15, 11}},  // still the first session
+		{time.Date(2021, 3, 2, 9, 30, 0, 0, time.UTC), [4]float64{15, 16, 17, 14}}, // next session opens
+		{time.Date(2021, 3, 2, 10, 0, 0, 0, time.UTC), [4]float64{16, 15, 16, 13}},
+	} {
+		candle := NewCandle(NewTimePeriod(c.start, time.Minute*30))
+		candle.OpenPrice = big.NewDecimal(c.ochl[0])
+		candle.ClosePrice = big.NewDecimal(c.ochl[1])
+		candle.MaxPrice = big.NewDecimal(c.ochl[2])
+		candle.MinPrice = big.NewDecimal(c.ochl[3])
+		series.AddCandle(candle)
+	}
+
+	sessionStart := 9*time.Hour + 30*time.Minute
+
+	t.Run("Open", func(t *testing.T) {
+		indicatorEquals(t, []float64{9, 10, 10, 10, 15, 15}, NewSessionOpenIndicator(series, sessionStart))
+	})
+
+	t.Run("High", func(t *testing.T) {
+		indicatorEquals(t, []float64{11, 13, 13, 15, 17, 17}, NewSessionHighIndicator(series, sessionStart))
+	})
+
+	t.Run("Low", func(t *testing.T) {
+		indicatorEquals(t, []float64{8, 10, 9, 9, 14, 13}, NewSessionLowIndicator(series, sessionStart))
+	})
+
+	t.Run("Prior close", func(t *testing.T) {
+		pci := NewPriorSessionCloseIndicator(series, sessionStart)
+
+		assert.True(t, pci.Calculate(0).NaN())
+		for i, want := range []float64{10, 10, 10, 14, 14} {
+			decimalEquals(t, want, pci.Calculate(i+1))
+		}
+	})
+
+	t.Run("Keeps local session start across DST", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+
+		dst := NewTimeSeries()
+		for _, start := range []time.Time{
+			time.Date(2021, 3, 13, 9, 30, 0, 0, newYork),
+			time.Date(2021, 3, 14, 9, 0, 0, 0, newYork), // clocks moved forward overnight
+			time.Date(2021, 3, 14, 9, 30, 0, 0, newYork),
+		} {
+			candle := NewCandle(NewTimePeriod(start, time.Minute*30))
+			candle.OpenPrice = big.NewDecimal(float64(start.Day()*100 + start.Minute()))
+			dst.AddCandle(candle)
+		}
+
+		indicatorEquals(t, []float64{1330, 1330, 1430}, NewSessionOpenIndicator(dst, sessionStart))
+	})
+}
@@ -0,0 +1,22 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type shiftIndicator struct {
+	indicator Indicator
+	shift     int
+}
+
+// NewShiftIndicator returns a derivative Indicator which returns the value of the underlying indicator shift bars ago.
+// Indices before the start of the underlying indicator are clamped to 0, so the first shift values all equal the
+// underlying indicator's first value.
+func NewShiftIndicator(indicator Indicator, shift int) Indicator {
+	return shiftIndicator{
+		indicator: indicator,
+		shift:     shift,
+	}
+}
+
+func (si shiftIndicator) Calculate(index int) big.Decimal {
+	return si.indicator.Calculate(Max(index-si.shift, 0))
+}
@@ -0,0 +1,30 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShiftIndicator(t *testing.T) {
+	t.Run("Shifts values back", func(t *testing.T) {
+		indicator := NewShiftIndicator(NewFixedIndicator(1, 2, 3, 4, 5), 2)
+
+		expected := []float64{1, 1, 1, 2, 3}
+		for i, value := range expected {
+			decimalEquals(t, value, indicator.Calculate(i))
+		}
+	})
+
+	t.Run("Zero shift", func(t *testing.T) {
+		indicator := NewShiftIndicator(NewFixedIndicator(1, 2, 3), 0)
+
+		indicatorEquals(t, []float64{1, 2, 3}, indicator)
+	})
+
+	t.Run("Does not read past the underlying indicator", func(t *testing.T) {
+		indicator := NewShiftIndicator(NewFixedIndicator(1, 2, 3), 1)
+
+		assert.EqualValues(t, "3", indicator.Calculate(3).String())
+	})
+}
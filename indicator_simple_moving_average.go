@@ -2,28 +2,59 @@ package techan
 
 import "github.com/sdcoffey/big"
 
+// SMAWarmup describes what an SMA returns before a full window of values is available
+type SMAWarmup int
+
+// SMAWarmupZero, SMAWarmupPartial, and SMAWarmupNaN enumerations
+const (
+	// SMAWarmupZero returns zero before a full window is available. This is the behavior of NewSimpleMovingAverage.
+	SMAWarmupZero SMAWarmup = iota
+	// SMAWarmupPartial returns the average of the values available so far, so every index has a value. This matches
+	// pandas' rolling(window, min_periods=1).mean().
+	SMAWarmupPartial
+	// SMAWarmupNaN returns big.NaN before a full window is available, so warm-up values can be told apart from real ones.
+	// This matches ta-lib and pandas' default rolling mean.
+	SMAWarmupNaN
+)
+
 type smaIndicator struct {
 	indicator Indicator
 	window    int
+	warmup    SMAWarmup
 }
 
 // NewSimpleMovingAverage returns a derivative Indicator which returns the average of the current value and preceding
 // values in the given windowSize.
 func NewSimpleMovingAverage(indicator Indicator, window int) Indicator {
-	return smaIndicator{indicator, window}
+	return NewSimpleMovingAverageWithWarmup(indicator, window, SMAWarmupZero)
+}
+
+// NewSimpleMovingAverageWithWarmup returns an SMA indicator like NewSimpleMovingAverage, whose values before a full
+// window is available are chosen according to the given warmup. The warm-up only affects the first window-1 values,
+// but those can matter for short backtests, or when reconciling against other tools.
+func NewSimpleMovingAverageWithWarmup(indicator Indicator, window int, warmup SMAWarmup) Indicator {
+	return smaIndicator{indicator, window, warmup}
 }
 
 func (sma smaIndicator) Calculate(index int) big.Decimal {
-	if index < sma.window-1 {
-		return big.ZERO
+	start := index - sma.window + 1
+	if start < 0 {
+		switch sma.warmup {
+		case SMAWarmupPartial:
+			start = 0
+		case SMAWarmupNaN:
+			return big.NaN
+		default:
+			return big.ZERO
+		}
 	}
 
 	sum := big.ZERO
-	for i := index; i > index-sma.window; i-- {
+	for i := index; i >= start; i-- {
 		sum = sum.Add(sma.indicator.Calculate(i))
 	}
 
-	result := sum.Div(big.NewFromInt(sma.window))
+	result := sum.Div(big.NewFromInt(index - start + 1))
 
 	return result
 }
@@ -1,6 +1,10 @@
 package techan
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestSimpleMovingAverage(t *testing.T) {
 	expectedValues := []float64{
@@ -22,3 +26,24 @@ func TestSimpleMovingAverage(t *testing.T) {
 
 	indicatorEquals(t, expectedValues, NewSimpleMovingAverage(closePriceIndicator, 3))
 }
+
+func TestSimpleMovingAverageWithWarmup(t *testing.T) {
+	values := NewFixedIndicator(2, 4, 6, 8)
+
+	t.Run("zero", func(t *testing.T) {
+		indicatorEquals(t, []float64{0, 0, 4, 6}, NewSimpleMovingAverageWithWarmup(values, 3, SMAWarmupZero))
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		indicatorEquals(t, []float64{2, 3, 4, 6}, NewSimpleMovingAverageWithWarmup(values, 3, SMAWarmupPartial))
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		sma := NewSimpleMovingAverageWithWarmup(values, 3, SMAWarmupNaN)
+
+		assert.True(t, sma.Calculate(0).NaN())
+		assert.True(t, sma.Calculate(1).NaN())
+		decimalEquals(t, 4, sma.Calculate(2))
+		decimalEquals(t, 6, sma.Calculate(3))
+	})
+}
@@ -1,10 +1,6 @@
 package techan
 
-import (
-	"math"
-
-	"github.com/sdcoffey/big"
-)
+import "github.com/sdcoffey/big"
 
 type kIndicator struct {
 	closePrice Indicator
@@ -14,7 +10,7 @@ type kIndicator struct {
 }
 
 // NewFastStochasticIndicator returns a derivative Indicator which returns the fast stochastic indicator (%K) for the
-// given window.
+// given window. If the high and low over the window are equal, zero is returned.
 // https://www.investopedia.com/terms/s/stochasticoscillator.asp
 func NewFastStochasticIndicator(series *TimeSeries, timeframe int) Indicator {
 	return kIndicator{
@@ -30,11 +26,7 @@ func (k kIndicator) Calculate(index int) big.Decimal {
 	minVal := k.minValue.Calculate(index)
 	maxVal := k.maxValue.Calculate(index)
 
-	if minVal.EQ(maxVal) {
-		return big.NewDecimal(math.Inf(1))
-	}
-
-	return closeVal.Sub(minVal).Div(maxVal.Sub(minVal)).Mul(big.NewDecimal(100))
+	return SafeDivide(closeVal.Sub(minVal), maxVal.Sub(minVal), big.ZERO).Mul(big.NewDecimal(100))
 }
 
 type dIndicator struct {
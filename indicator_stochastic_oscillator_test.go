@@ -1,7 +1,6 @@
 package techan
 
 import (
-	"math"
 	"testing"
 
 	"github.com/sdcoffey/big"
@@ -81,5 +80,5 @@ func TestFastStochasticIndicatorNoPriceChange(t *testing.T) {
 	)
 
 	k := NewFastStochasticIndicator(ts, 2)
-	assert.Equal(t, big.NewDecimal(math.Inf(1)).FormattedString(2), k.Calculate(1).FormattedString(2))
+	assert.Equal(t, big.ZERO.FormattedString(2), k.Calculate(1).FormattedString(2))
 }
@@ -0,0 +1,31 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type stochasticRSIIndicator struct {
+	rsi      Indicator
+	minValue Indicator
+	maxValue Indicator
+}
+
+// NewStochasticRSIIndicator returns a derivative Indicator which returns the stochastic RSI: the position of the RSI
+// (over rsiWindow) within its own range over the last stochWindow values, as (RSI - lowest RSI) / (highest RSI - lowest
+// RSI). It oscillates between 0 and 1, and reacts faster than the RSI it's built on. If the RSI is flat over the
+// window, zero is returned.
+// https://www.investopedia.com/terms/s/stochrsi.asp
+func NewStochasticRSIIndicator(series *TimeSeries, rsiWindow, stochWindow int) Indicator {
+	rsi := NewRelativeStrengthIndexIndicator(NewClosePriceIndicator(series), rsiWindow)
+
+	return stochasticRSIIndicator{
+		rsi:      rsi,
+		minValue: NewMinimumValueIndicator(rsi, stochWindow),
+		maxValue: NewMaximumValueIndicator(rsi, stochWindow),
+	}
+}
+
+func (sri stochasticRSIIndicator) Calculate(index int) big.Decimal {
+	minVal := sri.minValue.Calculate(index)
+	maxVal := sri.maxValue.Calculate(index)
+
+	return SafeDivide(sri.rsi.Calculate(index).Sub(minVal), maxVal.Sub(minVal), big.ZERO)
+}
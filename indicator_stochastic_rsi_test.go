@@ -0,0 +1,24 @@
+package techan
+
+import "testing"
+
+func TestStochasticRSIIndicator(t *testing.T) {
+	indicator := NewStochasticRSIIndicator(mockedTimeSeries, 3, 3)
+
+	expectedValues := []float64{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		1,
+		0.9324,
+		0,
+		0.7149,
+		0,
+		0.2327,
+	}
+
+	indicatorEquals(t, expectedValues, indicator)
+}
@@ -0,0 +1,22 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type transformIndicator struct {
+	indicator Indicator
+	fn        func(big.Decimal) big.Decimal
+}
+
+// NewTransformIndicator returns a derivative Indicator which applies fn to the value of the base indicator at each
+// index, e.g., to take a logarithm, square root, or scale. fn is called on every calculation, so when it's costly
+// or the result feeds several indicators, cache it with Pipe(...).Transform(fn).Cached().
+func NewTransformIndicator(indicator Indicator, fn func(big.Decimal) big.Decimal) Indicator {
+	return transformIndicator{
+		indicator: indicator,
+		fn:        fn,
+	}
+}
+
+func (ti transformIndicator) Calculate(index int) big.Decimal {
+	return ti.fn(ti.indicator.Calculate(index))
+}
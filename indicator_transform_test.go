@@ -0,0 +1,31 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformIndicator(t *testing.T) {
+	t.Run("applies function to each value", func(t *testing.T) {
+		ti := NewTransformIndicator(NewFixedIndicator(1, 4, 9), big.Decimal.Sqrt)
+
+		indicatorEquals(t, []float64{1, 2, 3}, ti)
+	})
+
+	t.Run("cached through builder", func(t *testing.T) {
+		var calls int
+		double := func(d big.Decimal) big.Decimal {
+			calls++
+			return d.Mul(big.NewFromInt(2))
+		}
+
+		ti := Pipe(NewFixedIndicator(1, 2, 3)).Transform(double).Cached().Build()
+
+		decimalEquals(t, 4, ti.Calculate(1))
+		decimalEquals(t, 4, ti.Calculate(1))
+
+		assert.EqualValues(t, 1, calls)
+	})
+}
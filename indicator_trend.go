@@ -70,3 +70,16 @@ func sumX2(decimals []big.Decimal) big.Decimal {
 
 	return b
 }
+
+// trendLineFit returns the slope and intercept of the least squares line through values, where each value's x is its
+// position in the slice. The slope is zero when there are fewer than two values.
+func trendLineFit(values []big.Decimal) (slope, intercept big.Decimal) {
+	n := big.NewFromInt(len(values))
+	ab := sumXy(values).Mul(n).Sub(sumX(values).Mul(sumY(values)))
+	cd := sumX2(values).Mul(n).Sub(sumX(values).Pow(2))
+
+	slope = SafeDivide(ab, cd, big.ZERO)
+	intercept = sumY(values).Sub(slope.Mul(sumX(values))).Div(n)
+
+	return slope, intercept
+}
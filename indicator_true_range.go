@@ -7,7 +7,8 @@ type trueRangeIndicator struct {
 }
 
 // NewTrueRangeIndicator returns a base indicator
-// which calculates the true range at the current point in time for a series
+// which calculates the true range at the current point in time for a series. The first candle has no previous close,
+// so its true range is its high minus its low.
 // https://www.investopedia.com/terms/a/atr.asp
 func NewTrueRangeIndicator(series *TimeSeries) Indicator {
 	return trueRangeIndicator{
@@ -16,11 +17,11 @@ func NewTrueRangeIndicator(series *TimeSeries) Indicator {
 }
 
 func (tri trueRangeIndicator) Calculate(index int) big.Decimal {
+	candle := tri.series.Candles[index]
 	if index-1 < 0 {
-		return big.ZERO
+		return candle.MaxPrice.Sub(candle.MinPrice)
 	}
 
-	candle := tri.series.Candles[index]
 	previousClose := tri.series.Candles[index-1].ClosePrice
 
 	trueHigh := big.MaxSlice(candle.MaxPrice, previousClose)
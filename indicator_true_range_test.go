@@ -8,7 +8,7 @@ func TestTrueRangeIndicator(t *testing.T) {
 	trueRangeIndicator := NewTrueRangeIndicator(mockedTimeSeries)
 
 	expectedValues := []float64{
-		0,
+		2,
 		2,
 		2,
 		2,
@@ -0,0 +1,9 @@
+package techan
+
+// NewTWAPIndicator returns a derivative Indicator which returns the time-weighted average price of the series: the
+// simple average of the typical price of each candle over the trailing window, ignoring volume. Before a full window is
+// available, the average of the available candles is returned.
+// https://www.investopedia.com/terms/t/twap.asp
+func NewTWAPIndicator(series *TimeSeries, window int) Indicator {
+	return NewSimpleMovingAverageWithWarmup(NewTypicalPriceIndicator(series), window, SMAWarmupPartial)
+}
@@ -0,0 +1,15 @@
+package techan
+
+import "testing"
+
+func TestTWAPIndicator(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 10, 12, 8},
+		[]float64{10, 13, 14, 12},
+		[]float64{13, 12, 13, 11},
+		[]float64{12, 18, 20, 16},
+	)
+
+	// Typical prices are 10, 13, 12 and 18
+	indicatorEquals(t, []float64{10, 11.5, 11.6667, 14.3333}, NewTWAPIndicator(series, 3))
+}
@@ -0,0 +1,65 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type ultimateOscillatorIndicator struct {
+	trueRange      Indicator
+	buyingPressure Indicator
+	short          int
+	medium         int
+	long           int
+}
+
+// NewUltimateOscillatorIndicator returns a derivative Indicator which returns the ultimate oscillator of the series,
+// between 0 and 100. Buying pressure (the close minus the lower of the low and the previous close) is summed and divided
+// by the summed true range over each of the short, medium, and long windows, and the three averages are weighted 4, 2,
+// and 1 respectively. Zero is returned until long candles with a previous close are available. Windows of 7, 14, and 28
+// are typical.
+// https://www.investopedia.com/terms/u/ultimateoscillator.asp
+func NewUltimateOscillatorIndicator(series *TimeSeries, short, medium, long int) Indicator {
+	return ultimateOscillatorIndicator{
+		trueRange:      NewTrueRangeIndicator(series),
+		buyingPressure: buyingPressureIndicator{series},
+		short:          short,
+		medium:         medium,
+		long:           long,
+	}
+}
+
+func (uoi ultimateOscillatorIndicator) Calculate(index int) big.Decimal {
+	if index < uoi.long {
+		return big.ZERO
+	}
+
+	average := func(window int) big.Decimal {
+		pressure := big.ZERO
+		trueRange := big.ZERO
+		for i := index; i > index-window; i-- {
+			pressure = pressure.Add(uoi.buyingPressure.Calculate(i))
+			trueRange = trueRange.Add(uoi.trueRange.Calculate(i))
+		}
+
+		return SafeDivide(pressure, trueRange, big.ZERO)
+	}
+
+	weighted := average(uoi.short).Mul(big.NewFromInt(4)).
+		Add(average(uoi.medium).Mul(big.NewFromInt(2))).
+		Add(average(uoi.long))
+
+	return weighted.Div(big.NewFromInt(7)).Mul(big.NewFromInt(100))
+}
+
+type buyingPressureIndicator struct {
+	series *TimeSeries
+}
+
+func (bpi buyingPressureIndicator) Calculate(index int) big.Decimal {
+	candle := bpi.series.Candles[index]
+
+	trueLow := candle.MinPrice
+	if index > 0 {
+		trueLow = big.MinSlice(trueLow, bpi.series.Candles[index-1].ClosePrice)
+	}
+
+	return candle.ClosePrice.Sub(trueLow)
+}
@@ -0,0 +1,22 @@
+package techan
+
+import "testing"
+
+func TestUltimateOscillatorIndicator(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 12, 12, 8},
+		[]float64{11, 14, 14, 9},
+		[]float64{10, 20, 24, 10},
+		[]float64{9, 10, 11, 9},
+		[]float64{11, 14, 14, 9},
+		[]float64{9, 10, 11, 9},
+		[]float64{10, 12, 12, 10},
+		[]float64{9, 10, 11, 8},
+		[]float64{6, 5, 8, 1},
+		[]float64{15, 12, 18, 9},
+	)
+
+	uo := NewUltimateOscillatorIndicator(series, 2, 3, 4)
+
+	indicatorEquals(t, []float64{0, 0, 0, 0, 45.2381, 50.7483, 49.1275, 60.0108, 48.0403, 50.5102}, uo)
+}
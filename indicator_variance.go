@@ -5,13 +5,22 @@ import "github.com/sdcoffey/big"
 // NewVarianceIndicator provides a way to find the variance in a base indicator, where variances is the sum of squared
 // deviations from the mean at any given index in the time series.
 func NewVarianceIndicator(ind Indicator) Indicator {
+	return NewWindowedVarianceIndicator(ind, -1)
+}
+
+// NewWindowedVarianceIndicator returns an indicator which calculates the population variance of the underlying
+// indicator over a window. Before a full window is available, the variance of the available values is returned. Use a
+// window value of -1 to include all values in the underlying indicator.
+func NewWindowedVarianceIndicator(ind Indicator, window int) Indicator {
 	return varianceIndicator{
 		Indicator: ind,
+		window:    window,
 	}
 }
 
 type varianceIndicator struct {
 	Indicator Indicator
+	window    int
 }
 
 // Calculate returns the Variance for this indicator at the given index
@@ -20,14 +29,23 @@ func (vi varianceIndicator) Calculate(index int) big.Decimal {
 		return big.ZERO
 	}
 
-	avgIndicator := NewSimpleMovingAverage(vi.Indicator, index+1)
-	avg := avgIndicator.Calculate(index)
-	variance := big.ZERO
+	start := 0
+	if vi.window > 0 {
+		start = Max(index-vi.window+1, 0)
+	}
+	count := big.NewFromInt(index - start + 1)
 
-	for i := 0; i <= index; i++ {
+	sum := big.ZERO
+	for i := start; i <= index; i++ {
+		sum = sum.Add(vi.Indicator.Calculate(i))
+	}
+	avg := sum.Div(count)
+
+	variance := big.ZERO
+	for i := start; i <= index; i++ {
 		pow := vi.Indicator.Calculate(i).Sub(avg).Pow(2)
 		variance = variance.Add(pow)
 	}
 
-	return variance.Div(big.NewDecimal(float64(index + 1)))
+	return variance.Div(count)
 }
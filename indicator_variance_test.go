@@ -34,3 +34,35 @@ func TestVarianceIndicator(t *testing.T) {
 		assert.EqualValues(t, "151.27", varInd.Calculate(6).FormattedString(2))
 	})
 }
+
+func TestWindowedVarianceIndicator(t *testing.T) {
+	series := mockTimeSeriesFl(
+		10,
+		2,
+		38,
+		23,
+		38,
+		23,
+		21)
+
+	varInd := NewWindowedVarianceIndicator(NewClosePriceIndicator(series), 3)
+
+	t.Run("early bars use available values", func(t *testing.T) {
+		assert.EqualValues(t, "0.00", varInd.Calculate(0).FormattedString(2))
+		assert.EqualValues(t, "16.00", varInd.Calculate(1).FormattedString(2))
+		assert.EqualValues(t, "238.22", varInd.Calculate(2).FormattedString(2))
+	})
+
+	t.Run("uses trailing window", func(t *testing.T) {
+		assert.EqualValues(t, "218.00", varInd.Calculate(3).FormattedString(2))
+		assert.EqualValues(t, "50.00", varInd.Calculate(4).FormattedString(2))
+		assert.EqualValues(t, "50.00", varInd.Calculate(5).FormattedString(2))
+		assert.EqualValues(t, "57.56", varInd.Calculate(6).FormattedString(2))
+	})
+
+	t.Run("matches windowed standard deviation", func(t *testing.T) {
+		stdDev := NewWindowedStandardDeviationIndicator(NewClosePriceIndicator(series), 3)
+
+		assert.EqualValues(t, varInd.Calculate(6).Sqrt().FormattedString(4), stdDev.Calculate(6).FormattedString(4))
+	})
+}
@@ -0,0 +1,63 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+var volumeIndexBase = big.NewDecimal(1000)
+
+type volumeIndexIndicator struct {
+	series      *TimeSeries
+	positive    bool
+	resultCache resultCache
+}
+
+// NewNegativeVolumeIndexIndicator returns an Indicator which returns the negative volume index of the series. Starting
+// from a base of 1000, the index is moved by the percentage change in close price only on candles where volume fell
+// versus the previous candle, and is carried forward unchanged otherwise.
+// https://www.investopedia.com/terms/n/nvi.asp
+func NewNegativeVolumeIndexIndicator(series *TimeSeries) Indicator {
+	return &volumeIndexIndicator{
+		series:      series,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+// NewPositiveVolumeIndexIndicator returns an Indicator which returns the positive volume index of the series. Starting
+// from a base of 1000, the index is moved by the percentage change in close price only on candles where volume rose
+// versus the previous candle, and is carried forward unchanged otherwise.
+// https://www.investopedia.com/terms/p/pvi.asp
+func NewPositiveVolumeIndexIndicator(series *TimeSeries) Indicator {
+	return &volumeIndexIndicator{
+		series:      series,
+		positive:    true,
+		resultCache: make([]*big.Decimal, 1000),
+	}
+}
+
+func (vii *volumeIndexIndicator) Calculate(index int) big.Decimal {
+	if cachedValue := returnIfCached(vii, index, func(i int) big.Decimal {
+		return volumeIndexBase
+	}); cachedValue != nil {
+		return *cachedValue
+	}
+
+	prev := vii.series.Candles[index-1]
+	candle := vii.series.Candles[index]
+	result := vii.Calculate(index - 1)
+
+	if (vii.positive && candle.Volume.GT(prev.Volume)) || (!vii.positive && candle.Volume.LT(prev.Volume)) {
+		change := SafeDivide(candle.ClosePrice.Sub(prev.ClosePrice), prev.ClosePrice, big.ZERO)
+		result = result.Add(result.Mul(change))
+	}
+
+	cacheResult(vii, index, result)
+
+	return result
+}
+
+func (vii volumeIndexIndicator) cache() resultCache { return vii.resultCache }
+
+func (vii *volumeIndexIndicator) setCache(newCache resultCache) {
+	vii.resultCache = newCache
+}
+
+func (vii volumeIndexIndicator) windowSize() int { return 1 }
@@ -0,0 +1,28 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+)
+
+func mockVolumeIndexSeries() *TimeSeries {
+	series := mockTimeSeriesFl(10, 11, 12, 9, 9.9)
+	for i, volume := range []float64{100, 80, 120, 60, 90} {
+		series.Candles[i].Volume = big.NewDecimal(volume)
+	}
+
+	return series
+}
+
+func TestNegativeVolumeIndexIndicator(t *testing.T) {
+	nvi := NewNegativeVolumeIndexIndicator(mockVolumeIndexSeries())
+
+	indicatorEquals(t, []float64{1000, 1100, 1100, 825, 825}, nvi)
+}
+
+func TestPositiveVolumeIndexIndicator(t *testing.T) {
+	pvi := NewPositiveVolumeIndexIndicator(mockVolumeIndexSeries())
+
+	indicatorEquals(t, []float64{1000, 1000, 1090.9091, 1090.9091, 1200}, pvi)
+}
@@ -0,0 +1,33 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type zScoreIndicator struct {
+	indicator Indicator
+	mean      Indicator
+	stdev     Indicator
+	window    int
+}
+
+// NewZScoreIndicator returns a derivative Indicator which returns the number of standard deviations the current value
+// of the underlying indicator lies from its simple moving average over the given window. Values before a full window is
+// available, or where the window has no deviation, are zero.
+// https://www.investopedia.com/terms/z/zscore.asp
+func NewZScoreIndicator(indicator Indicator, window int) Indicator {
+	return zScoreIndicator{
+		indicator: indicator,
+		mean:      NewSimpleMovingAverage(indicator, window),
+		stdev:     NewWindowedStandardDeviationIndicator(indicator, window),
+		window:    window,
+	}
+}
+
+func (zsi zScoreIndicator) Calculate(index int) big.Decimal {
+	if index < zsi.window-1 {
+		return big.ZERO
+	}
+
+	deviation := zsi.indicator.Calculate(index).Sub(zsi.mean.Calculate(index))
+
+	return SafeDivide(deviation, zsi.stdev.Calculate(index), big.ZERO)
+}
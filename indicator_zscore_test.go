@@ -0,0 +1,27 @@
+package techan
+
+import "testing"
+
+func TestZScoreIndicator(t *testing.T) {
+	t.Run("measures deviation from the window mean", func(t *testing.T) {
+		zsi := NewZScoreIndicator(NewFixedIndicator(1, 2, 3, 3, 3, 9), 3)
+
+		indicatorEquals(t, []float64{0, 0, 1.2247, 0.7071, 0, 1.4142}, zsi)
+	})
+
+	t.Run("returns zero with no deviation", func(t *testing.T) {
+		zsi := NewZScoreIndicator(NewFixedIndicator(4, 4, 4), 2)
+
+		indicatorEquals(t, []float64{0, 0, 0}, zsi)
+	})
+
+	t.Run("on the ratio of two series", func(t *testing.T) {
+		a := mockTimeSeriesFl(10, 20, 30, 30)
+		b := mockTimeSeriesFl(10, 10, 10, 15)
+
+		ratio := NewRatioIndicator(NewClosePriceIndicator(a), NewClosePriceIndicator(b))
+		zsi := NewZScoreIndicator(ratio, 3)
+
+		indicatorEquals(t, []float64{0, 0, 1.2247, -0.7071}, zsi)
+	})
+}
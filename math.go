@@ -1,5 +1,7 @@
 package techan
 
+import "github.com/sdcoffey/big"
+
 // Min returns the smaller integer of the two integers passed in
 func Min(i, j int) int {
 	if i < j {
@@ -38,3 +40,13 @@ func Abs(b int) int {
 
 	return b
 }
+
+// SafeDivide returns a divided by b, or fallback if b is zero. It's useful in indicators whose denominator may be zero
+// over flat windows, where dividing would otherwise yield Inf or NaN.
+func SafeDivide(a, b big.Decimal, fallback big.Decimal) big.Decimal {
+	if b.IsZero() {
+		return fallback
+	}
+
+	return a.Div(b)
+}
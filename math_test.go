@@ -3,6 +3,7 @@ package techan
 import (
 	"testing"
 
+	"github.com/sdcoffey/big"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,3 +36,13 @@ func BenchmarkAbs(b *testing.B) {
 	}
 	x++
 }
+
+func TestSafeDivide(t *testing.T) {
+	t.Run("Nonzero denominator", func(t *testing.T) {
+		assert.EqualValues(t, "2", SafeDivide(big.NewDecimal(6), big.NewDecimal(3), big.ZERO).String())
+	})
+
+	t.Run("Zero denominator returns fallback", func(t *testing.T) {
+		assert.EqualValues(t, "-1", SafeDivide(big.NewDecimal(6), big.ZERO, big.ONE.Neg()).String())
+	})
+}
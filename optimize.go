@@ -0,0 +1,61 @@
+package techan
+
+import (
+	"sort"
+	"sync"
+)
+
+// Optimize runs a grid search over every combination of the values in paramGrid. For each combination, build is
+// called to construct a Strategy, which is backtested against series with RunStrategy and scored with the provided
+// Analysis. The combination with the highest score is returned along with that score. Combinations are evaluated
+// concurrently, so build must return a Strategy that does not share mutable state (e.g. cached indicators) with the
+// strategies built for other combinations. When several combinations tie, the one enumerated first wins.
+func Optimize(paramGrid map[string][]float64, build func(params map[string]float64) Strategy, series *TimeSeries, score Analysis) (bestParams map[string]float64, bestScore float64) {
+	combinations := paramCombinations(paramGrid)
+	scores := make([]float64, len(combinations))
+
+	var wg sync.WaitGroup
+	for i, params := range combinations {
+		wg.Add(1)
+		go func(i int, params map[string]float64) {
+			defer wg.Done()
+			scores[i] = score.Analyze(RunStrategy(build(params), series))
+		}(i, params)
+	}
+	wg.Wait()
+
+	for i, s := range scores {
+		if i == 0 || s > bestScore {
+			bestParams = combinations[i]
+			bestScore = s
+		}
+	}
+
+	return bestParams, bestScore
+}
+
+func paramCombinations(paramGrid map[string][]float64) []map[string]float64 {
+	names := make([]string, 0, len(paramGrid))
+	for name := range paramGrid {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	combinations := []map[string]float64{{}}
+	for _, name := range names {
+		next := make([]map[string]float64, 0, len(combinations)*len(paramGrid[name]))
+		for _, combination := range combinations {
+			for _, value := range paramGrid[name] {
+				params := make(map[string]float64, len(combination)+1)
+				for k, v := range combination {
+					params[k] = v
+				}
+				params[name] = value
+				next = append(next, params)
+			}
+		}
+		combinations = next
+	}
+
+	return combinations
+}
@@ -0,0 +1,49 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimize(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3, 4, 5, 4, 3, 2, 1, 2, 3)
+
+	build := func(params map[string]float64) Strategy {
+		closePrice := NewClosePriceIndicator(series)
+		return RuleStrategy{
+			EntryRule: OverIndicatorRule{First: NewConstantIndicator(params["entry"]), Second: closePrice},
+			ExitRule:  OverIndicatorRule{First: closePrice, Second: NewConstantIndicator(params["exit"])},
+		}
+	}
+
+	t.Run("returns the best scoring combination", func(t *testing.T) {
+		grid := map[string][]float64{
+			"entry": {1.5, 2.5},
+			"exit":  {3.5, 4.5},
+		}
+
+		params, score := Optimize(grid, build, series, TotalProfitAnalysis{})
+
+		assert.EqualValues(t, map[string]float64{"entry": 2.5, "exit": 4.5}, params)
+		assert.EqualValues(t, 3, score)
+	})
+
+	t.Run("returns nil when grid has no combinations", func(t *testing.T) {
+		params, score := Optimize(map[string][]float64{"entry": {}}, build, series, TotalProfitAnalysis{})
+
+		assert.Nil(t, params)
+		assert.EqualValues(t, 0, score)
+	})
+}
+
+func TestParamCombinations(t *testing.T) {
+	combinations := paramCombinations(map[string][]float64{
+		"a": {1, 2},
+		"b": {3, 4, 5},
+	})
+
+	assert.Len(t, combinations, 6)
+	assert.EqualValues(t, map[string]float64{"a": 1, "b": 3}, combinations[0])
+	assert.EqualValues(t, map[string]float64{"a": 2, "b": 5}, combinations[5])
+}
@@ -0,0 +1,38 @@
+package techan
+
+// Portfolio is a collection of trading records, one per security, used to coordinate strategies trading several
+// securities at once.
+type Portfolio struct {
+	Records map[string]*TradingRecord
+}
+
+// NewPortfolio returns a new, empty, Portfolio
+func NewPortfolio() (p *Portfolio) {
+	p = new(Portfolio)
+	p.Records = make(map[string]*TradingRecord)
+
+	return p
+}
+
+// Record returns the trading record for the given security, creating it if the portfolio doesn't have one yet
+func (p *Portfolio) Record(security string) *TradingRecord {
+	record, ok := p.Records[security]
+	if !ok {
+		record = NewTradingRecord()
+		p.Records[security] = record
+	}
+
+	return record
+}
+
+// OpenPositions returns the number of records in the portfolio whose current position is open
+func (p *Portfolio) OpenPositions() int {
+	var open int
+	for _, record := range p.Records {
+		if record.CurrentPosition().IsOpen() {
+			open++
+		}
+	}
+
+	return open
+}
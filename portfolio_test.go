@@ -0,0 +1,29 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortfolio_Record(t *testing.T) {
+	portfolio := NewPortfolio()
+
+	record := portfolio.Record(example)
+
+	assert.NotNil(t, record)
+	assert.Equal(t, record, portfolio.Record(example))
+	assert.Len(t, portfolio.Records, 1)
+}
+
+func TestPortfolio_OpenPositions(t *testing.T) {
+	portfolio := NewPortfolio()
+
+	assert.EqualValues(t, 0, portfolio.OpenPositions())
+
+	portfolio.Record("A").Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE})
+	portfolio.Record("B")
+
+	assert.EqualValues(t, 1, portfolio.OpenPositions())
+}
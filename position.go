@@ -64,6 +64,18 @@ func (p *Position) ExitOrder() *Order {
 	return p.orders[1]
 }
 
+// legs returns the orders recorded for this position so far, in the order they were executed
+func (p *Position) legs() []*Order {
+	legs := make([]*Order, 0, len(p.orders))
+	for _, order := range p.orders {
+		if order != nil {
+			legs = append(legs, order)
+		}
+	}
+
+	return legs
+}
+
 // CostBasis returns the price to enter this order
 func (p *Position) CostBasis() big.Decimal {
 	if p.EntranceOrder() != nil {
@@ -81,6 +93,20 @@ func (p *Position) ExitValue() big.Decimal {
 	return big.ZERO
 }
 
+// RealizedPnL returns the profit realized by exiting this position, taking the direction of the position into account.
+// A position that has not been exited has realized nothing and returns zero.
+func (p *Position) RealizedPnL() big.Decimal {
+	if !p.IsClosed() {
+		return big.ZERO
+	}
+
+	if p.IsShort() {
+		return p.ExitValue().Sub(p.CostBasis()).Neg()
+	}
+
+	return p.ExitValue().Sub(p.CostBasis())
+}
+
 func (p *Position) ChangeStopLoss(newSLPrice big.Decimal) bool {
 	if p.IsClosed() {
 		return false
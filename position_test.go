@@ -156,3 +156,58 @@ func TestPosition_ExitValue(t *testing.T) {
 		assert.EqualValues(t, "12.00", p.ExitValue().FormattedString(2))
 	})
 }
+
+func TestPosition_RealizedPnL(t *testing.T) {
+	t.Run("Open position returns zero", func(t *testing.T) {
+		position := NewPosition(Order{
+			Side:   BUY,
+			Amount: big.ONE,
+			Price:  big.NewFromString("2"),
+		}, big.NaN, big.NaN)
+
+		assert.EqualValues(t, "0", position.RealizedPnL().String())
+	})
+
+	t.Run("Long position", func(t *testing.T) {
+		position := NewPosition(Order{
+			Side:   BUY,
+			Amount: big.NewFromString("2"),
+			Price:  big.NewFromString("2"),
+		}, big.NaN, big.NaN)
+		position.Exit(Order{
+			Side:   SELL,
+			Amount: big.NewFromString("2"),
+			Price:  big.NewFromString("3"),
+		})
+
+		assert.EqualValues(t, "2", position.RealizedPnL().String())
+	})
+
+	t.Run("Short position", func(t *testing.T) {
+		position := NewPosition(Order{
+			Side:   SELL,
+			Amount: big.NewFromString("2"),
+			Price:  big.NewFromString("3"),
+		}, big.NaN, big.NaN)
+		position.Exit(Order{
+			Side:   BUY,
+			Amount: big.NewFromString("2"),
+			Price:  big.NewFromString("2"),
+		})
+
+		assert.EqualValues(t, "2", position.RealizedPnL().String())
+	})
+}
+
+func TestPosition_legs(t *testing.T) {
+	position := new(Position)
+	assert.Len(t, position.legs(), 0)
+
+	entrance := Order{Side: BUY, Amount: big.ONE, Price: big.ONE}
+	position.Enter(entrance)
+	assert.EqualValues(t, []*Order{&entrance}, position.legs())
+
+	exit := Order{Side: SELL, Amount: big.ONE, Price: big.TEN}
+	position.Exit(exit)
+	assert.EqualValues(t, []*Order{&entrance, &exit}, position.legs())
+}
@@ -0,0 +1,406 @@
+package techan
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/sdcoffey/big"
+)
+
+// PeriodicReturns buckets the profit of each closed trade in the record by the calendar period of its exit and returns
+// the summed profit per bucket. Period may be "month", in which case buckets are keyed as "2006-01", or "year", in
+// which case buckets are keyed as "2006". PeriodicReturns panics on any other period.
+func PeriodicReturns(record *TradingRecord, period string) map[string]float64 {
+	var layout string
+	switch period {
+	case "month":
+		layout = "2006-01"
+	case "year":
+		layout = "2006"
+	default:
+		panic(fmt.Errorf("error bucketing returns: unknown period %q", period))
+	}
+
+	returns := make(map[string]float64)
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		key := trade.ExitOrder().ExecutionTime.Format(layout)
+		returns[key] += trade.RealizedPnL().Float()
+	}
+
+	return returns
+}
+
+// HoldingPeriodHistogram returns the number of closed trades in the record whose holding time falls into each bucket.
+// Holding time is measured from the entrance order's execution time to the exit order's execution time, and a trade
+// held for d falls into bucket int(d / bucket). HoldingPeriodHistogram panics if bucket is not positive.
+func HoldingPeriodHistogram(record *TradingRecord, bucket time.Duration) map[int]int {
+	if bucket <= 0 {
+		panic(fmt.Errorf("error creating holding period histogram: bucket must be positive, got %v", bucket))
+	}
+
+	histogram := make(map[int]int)
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		held := trade.ExitOrder().ExecutionTime.Sub(trade.EntranceOrder().ExecutionTime)
+		histogram[int(held/bucket)]++
+	}
+
+	return histogram
+}
+
+// BestTrade returns the closed trade in the record with the highest profit, or nil if the record has no closed trades
+func BestTrade(record *TradingRecord) *Position {
+	return extremeTrade(record, 1)
+}
+
+// WorstTrade returns the closed trade in the record with the lowest profit, or nil if the record has no closed trades
+func WorstTrade(record *TradingRecord) *Position {
+	return extremeTrade(record, -1)
+}
+
+func extremeTrade(record *TradingRecord, cmp int) *Position {
+	var extreme *Position
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		if extreme == nil || trade.RealizedPnL().Cmp(extreme.RealizedPnL()) == cmp {
+			extreme = trade
+		}
+	}
+
+	return extreme
+}
+
+// Streak is a run of consecutive trades with the same outcome
+type Streak struct {
+	Profitable bool
+	Count      int
+	Profit     float64
+}
+
+// Streaks returns each run of consecutive profitable or unprofitable closed trades in the record, in order, along with
+// the total profit of the trades in the run. Trades are classified the same way as in WinStreakAnalysis and
+// LoseStreakAnalysis.
+func Streaks(record *TradingRecord) []Streak {
+	var streaks []Streak
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		profitable := isProfitable(trade)
+		if len(streaks) == 0 || streaks[len(streaks)-1].Profitable != profitable {
+			streaks = append(streaks, Streak{Profitable: profitable})
+		}
+
+		current := &streaks[len(streaks)-1]
+		current.Count++
+		current.Profit += trade.RealizedPnL().Float()
+	}
+
+	return streaks
+}
+
+// EquityCurve returns startingCapital followed by the equity after each closed trade in the record, with the profits of
+// consecutive trades adding up according to mode. See EquityMode for which mode matches how the record was sized.
+func EquityCurve(record *TradingRecord, startingCapital float64, mode EquityMode) []float64 {
+	return equityCurve(record, startingCapital, mode)
+}
+
+// UnderwaterCurve returns the exit time of each closed trade in the record, along with the drawdown of the equity curve
+// from its running peak after that trade. The equity curve starts at startingCapital and moves by the profit of each
+// closed trade. Drawdowns are given as a fraction of the peak: zero at a new high, and negative below it.
+func UnderwaterCurve(record *TradingRecord, startingCapital float64) ([]time.Time, []float64) {
+	equity := equityCurve(record, startingCapital, FixedSize)
+
+	times := make([]time.Time, 0, len(equity)-1)
+	drawdowns := make([]float64, 0, len(equity)-1)
+
+	peak := startingCapital
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		current := equity[len(times)+1]
+		peak = math.Max(peak, current)
+
+		var drawdown float64
+		if peak > 0 {
+			drawdown = (current - peak) / peak
+		}
+
+		times = append(times, trade.ExitOrder().ExecutionTime)
+		drawdowns = append(drawdowns, drawdown)
+	}
+
+	return times, drawdowns
+}
+
+// Summary collects the metrics most commonly used to judge a backtest
+type Summary struct {
+	TotalProfit  float64
+	NumTrades    int
+	WinRate      float64
+	ProfitFactor float64
+	AverageWin   float64
+	AverageLoss  float64
+	MaxDrawdown  float64
+	SharpeRatio  float64
+}
+
+// Summarize returns a Summary of the trading record, computed with the corresponding analyses. WinRate is the fraction
+// of trades that were profitable, MaxDrawdown is given as in MaximumDrawdownAnalysis, and SharpeRatio is measured over
+// the candles of series as in SharpeRatioAnalysis.
+func Summarize(record *TradingRecord, series *TimeSeries, startingCapital float64) Summary {
+	summary := Summary{
+		TotalProfit:  TotalProfitAnalysis{}.Analyze(record),
+		NumTrades:    len(record.Trades),
+		ProfitFactor: ProfitFactorAnalysis{}.Analyze(record),
+		AverageWin:   AverageWinAnalysis{}.Analyze(record),
+		AverageLoss:  AverageLossAnalysis{}.Analyze(record),
+		MaxDrawdown:  MaximumDrawdownAnalysis{StartingCapital: startingCapital}.Analyze(record),
+		SharpeRatio:  SharpeRatioAnalysis{TimeSeries: series, StartingCapital: startingCapital}.Analyze(record),
+	}
+
+	if summary.NumTrades > 0 {
+		summary.WinRate = ProfitableTradesAnalysis{}.Analyze(record) / float64(summary.NumTrades)
+	}
+
+	return summary
+}
+
+// WriteBlotter writes every order in the record to w, one per line, for reconciliation with downstream systems. Orders
+// are written in the order they were executed: the entrance and exit of each trade, followed by the entrance of the
+// open position, if any. Each line is made of pipe-delimited FIX tag=value fields, always in the same order:
+//
+//	55=<symbol>|54=<side>|38=<quantity>|44=<price>|60=<timestamp>
+//
+// where side is 1 for buys and 2 for sells, quantity and price are exact decimals, and the timestamp is the order's
+// execution time in UTC, formatted as YYYYMMDD-HH:MM:SS.sss. WriteBlotter returns the first error returned by w.
+func WriteBlotter(w io.Writer, record *TradingRecord) error {
+	writeOrder := func(order *Order) error {
+		side := 1
+		if order.Side == SELL {
+			side = 2
+		}
+
+		_, err := fmt.Fprintf(w, "55=%s|54=%d|38=%s|44=%s|60=%s\n",
+			order.Security,
+			side,
+			decimalText(order.Amount),
+			decimalText(order.Price),
+			order.ExecutionTime.UTC().Format("20060102-15:04:05.000"),
+		)
+		return err
+	}
+
+	positions := make([]*Position, 0, len(record.Trades)+1)
+	positions = append(positions, record.Trades...)
+	positions = append(positions, record.CurrentPosition())
+
+	for _, position := range positions {
+		for _, order := range position.legs() {
+			if err := writeOrder(order); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MonteCarloDrawdown estimates how severe the record's drawdowns could have been had its closed trades happened in a
+// different order. It shuffles the profits of the closed trades iterations times and, for each shuffle, measures the
+// maximum drawdown of the cumulative profit curve: the largest fall from a running peak, zero or negative. Unlike
+// MaximumDrawdownAnalysis, which returns a fraction of equity, drawdowns are given in currency units, the same units as
+// the trades' profit. It returns the median of those drawdowns and their 95th percentile by severity, i.e., the
+// drawdown that only 5% of shuffles were worse than. Shuffles are drawn from a source seeded with seed, so the results
+// are deterministic. MonteCarloDrawdown panics if iterations is less than 1.
+func MonteCarloDrawdown(record *TradingRecord, iterations int, seed int64) (median, p95 float64) {
+	if iterations < 1 {
+		panic(fmt.Errorf("error running monte carlo drawdown: iterations must be at least 1, got %d", iterations))
+	}
+
+	var profits []float64
+	for _, trade := range record.Trades {
+		if trade.IsClosed() {
+			profits = append(profits, trade.RealizedPnL().Float())
+		}
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	drawdowns := make([]float64, iterations)
+	for i := range drawdowns {
+		random.Shuffle(len(profits), func(a, b int) {
+			profits[a], profits[b] = profits[b], profits[a]
+		})
+
+		var equity, peak float64
+		for _, profit := range profits {
+			equity += profit
+			peak = math.Max(peak, equity)
+			drawdowns[i] = math.Min(drawdowns[i], equity-peak)
+		}
+	}
+
+	sort.Float64s(drawdowns)
+
+	median = drawdowns[iterations/2]
+	if iterations%2 == 0 {
+		median = (drawdowns[iterations/2-1] + drawdowns[iterations/2]) / 2
+	}
+
+	p95 = drawdowns[int(float64(iterations-1)*0.05)]
+
+	return median, p95
+}
+
+// ReturnsStatistics returns the mean, standard deviation, skewness and excess kurtosis of the returns of the closed
+// trades in the record. Each trade's return is its profit as a fraction of its cost basis, so that short trades which
+// made money have positive returns. A positive skew means the returns include rare large wins, and a positive excess
+// kurtosis means they have fatter tails than a normal distribution. All four are population statistics, and are zero
+// if the record has fewer than two closed trades; skewness and kurtosis are also zero if the returns have no deviation.
+func ReturnsStatistics(record *TradingRecord) (mean, stddev, skew, kurtosis float64) {
+	var returns []float64
+	for _, trade := range record.Trades {
+		if trade.IsClosed() {
+			returns = append(returns, SafeDivide(trade.RealizedPnL(), trade.CostBasis(), big.ZERO).Float())
+		}
+	}
+
+	if len(returns) < 2 {
+		return 0, 0, 0, 0
+	}
+
+	mean = meanReturn(returns)
+
+	var m2, m3, m4 float64
+	for _, r := range returns {
+		deviation := r - mean
+		m2 += deviation * deviation
+		m3 += deviation * deviation * deviation
+		m4 += deviation * deviation * deviation * deviation
+	}
+
+	n := float64(len(returns))
+	m2, m3, m4 = m2/n, m3/n, m4/n
+
+	stddev = math.Sqrt(m2)
+	if stddev == 0 {
+		return mean, 0, 0, 0
+	}
+
+	return mean, stddev, m3 / math.Pow(stddev, 3), m4/(m2*m2) - 3
+}
+
+// MarkToMarketCurve returns the equity of the record at the end of every candle in the series, including the unrealized
+// profit of any position open at the time. Like the equity used by SharpeRatioAnalysis, it starts at startingCapital
+// and includes the profit of every trade closed before the end of each candle. A position that was entered before the
+// end of a candle and not exited by then is additionally marked to that candle's close, so the curve shows drawdowns
+// suffered while a trade is open, not only those realized when it closes.
+func MarkToMarketCurve(record *TradingRecord, series *TimeSeries, startingCapital float64) []float64 {
+	equity := equityAtCandles(record, series, startingCapital)
+
+	positions := record.Trades
+	if record.CurrentPosition().IsOpen() {
+		positions = append(positions[:len(positions):len(positions)], record.CurrentPosition())
+	}
+
+	for _, position := range positions {
+		entrance := position.EntranceOrder()
+		for i := candleIndexAt(series, entrance.ExecutionTime); i < len(series.Candles); i++ {
+			candle := series.Candles[i]
+			if !entrance.ExecutionTime.Before(candle.Period.End) {
+				continue
+			}
+			if position.IsClosed() && position.ExitOrder().ExecutionTime.Before(candle.Period.End) {
+				break
+			}
+
+			unrealized := candle.ClosePrice.Mul(entrance.Amount).Sub(position.CostBasis())
+			if position.IsShort() {
+				unrealized = unrealized.Neg()
+			}
+
+			equity[i] += unrealized.Float()
+		}
+	}
+
+	return equity
+}
+
+// AverageBarsInTrade returns the average number of candles of the series that closed trades in the record were held,
+// separately for profitable and unprofitable trades as classified in Streaks. A trade is held from the candle of its
+// entrance to the candle of its exit. Either average is zero if there were no trades of that kind.
+func AverageBarsInTrade(record *TradingRecord, series *TimeSeries) (winBars, lossBars float64) {
+	var wins, losses int
+	for _, trade := range record.Trades {
+		if !trade.IsClosed() {
+			continue
+		}
+
+		if isProfitable(trade) {
+			winBars += float64(barsHeld(series, trade))
+			wins++
+		} else {
+			lossBars += float64(barsHeld(series, trade))
+			losses++
+		}
+	}
+
+	if wins > 0 {
+		winBars /= float64(wins)
+	}
+	if losses > 0 {
+		lossBars /= float64(losses)
+	}
+
+	return winBars, lossBars
+}
+
+// WriteIndicatorsCSV writes the close price and the value of each named indicator at every candle in the series to w as
+// CSV, to inspect why rules fire. The header is "time,close" followed by the indicator names in sorted order, and each
+// row holds the candle's start time in RFC 3339 format, its close price, and the value of each indicator at its index.
+// Decimals are written exactly, as in WriteBlotter. WriteIndicatorsCSV returns the first error returned by w.
+func WriteIndicatorsCSV(w io.Writer, series *TimeSeries, named map[string]Indicator) error {
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append([]string{"time", "close"}, names...)); err != nil {
+		return err
+	}
+
+	for i, candle := range series.Candles {
+		row := make([]string, 0, len(names)+2)
+		row = append(row, candle.Period.Start.Format(time.RFC3339), decimalText(candle.ClosePrice))
+		for _, name := range names {
+			row = append(row, decimalText(named[name].Calculate(i)))
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
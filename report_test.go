@@ -0,0 +1,422 @@
+package techan
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodicReturns(t *testing.T) {
+	record := NewTradingRecord()
+
+	dates := []time.Time{
+		time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	orders := []Order{
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(1), ExecutionTime: dates[0]},
+		{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(3), ExecutionTime: dates[1]},
+		{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(3), ExecutionTime: dates[2]},
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(4), ExecutionTime: dates[3]},
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(4), ExecutionTime: dates[4]},
+		{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(8), ExecutionTime: dates[5]},
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(8), ExecutionTime: dates[6]},
+	}
+
+	for _, order := range orders {
+		record.Operate(order)
+	}
+
+	t.Run("month", func(t *testing.T) {
+		assert.EqualValues(t, map[string]float64{"2020-01": 1, "2020-02": 4}, PeriodicReturns(record, "month"))
+	})
+
+	t.Run("year", func(t *testing.T) {
+		assert.EqualValues(t, map[string]float64{"2020": 5}, PeriodicReturns(record, "year"))
+	})
+
+	t.Run("panics on unknown period", func(t *testing.T) {
+		assert.Panics(t, func() {
+			PeriodicReturns(record, "week")
+		})
+	})
+}
+
+func TestHoldingPeriodHistogram(t *testing.T) {
+	t.Run("Counts trades per bucket", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		now := time.Now()
+		orders := []Order{
+			{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: now},
+			{Side: SELL, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Minute * 30)},
+			{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Hour)},
+			{Side: SELL, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Hour * 2)},
+			{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Hour * 3)},
+			{Side: SELL, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Hour*4 + time.Minute*59)},
+			{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Hour * 5)},
+		}
+
+		for _, order := range orders {
+			record.Operate(order)
+		}
+
+		assert.EqualValues(t, map[int]int{0: 1, 1: 2}, HoldingPeriodHistogram(record, time.Hour))
+	})
+
+	t.Run("Panics when bucket is not positive", func(t *testing.T) {
+		assert.Panics(t, func() {
+			HoldingPeriodHistogram(NewTradingRecord(), 0)
+		})
+		assert.Panics(t, func() {
+			HoldingPeriodHistogram(NewTradingRecord(), -time.Hour)
+		})
+	})
+}
+
+func TestBestTrade(t *testing.T) {
+	t.Run("Empty record", func(t *testing.T) {
+		assert.Nil(t, BestTrade(NewTradingRecord()))
+	})
+
+	t.Run("Returns the most profitable trade", func(t *testing.T) {
+		record := extremeTradesRecord()
+
+		assert.Equal(t, record.Trades[1], BestTrade(record))
+	})
+}
+
+func TestWorstTrade(t *testing.T) {
+	t.Run("Empty record", func(t *testing.T) {
+		assert.Nil(t, WorstTrade(NewTradingRecord()))
+	})
+
+	t.Run("Returns the least profitable trade", func(t *testing.T) {
+		record := extremeTradesRecord()
+
+		assert.Equal(t, record.Trades[2], WorstTrade(record))
+	})
+}
+
+func extremeTradesRecord() *TradingRecord {
+	record := NewTradingRecord()
+
+	orders := []Order{
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(1)},
+		{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(2)},
+		{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(5)},
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(2)},
+		{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(4)},
+		{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(1)},
+	}
+
+	for _, order := range orders {
+		record.Operate(order)
+	}
+
+	return record
+}
+
+func TestStreaks(t *testing.T) {
+	t.Run("Empty record", func(t *testing.T) {
+		assert.Empty(t, Streaks(NewTradingRecord()))
+	})
+
+	t.Run("Groups consecutive outcomes", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		for _, prices := range [][2]float64{{1, 2}, {2, 4}, {4, 3}, {3, 1}, {1, 1}, {1, 5}} {
+			record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(prices[0])})
+			record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(prices[1])})
+		}
+
+		expected := []Streak{
+			{Profitable: true, Count: 2, Profit: 3},
+			{Profitable: false, Count: 3, Profit: -3},
+			{Profitable: true, Count: 1, Profit: 4},
+		}
+
+		assert.EqualValues(t, expected, Streaks(record))
+	})
+}
+
+func TestUnderwaterCurve(t *testing.T) {
+	t.Run("Empty record", func(t *testing.T) {
+		times, drawdowns := UnderwaterCurve(NewTradingRecord(), 100)
+
+		assert.Empty(t, times)
+		assert.Empty(t, drawdowns)
+	})
+
+	t.Run("Drawdown from running peak", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		now := time.Now()
+		for i, prices := range [][2]float64{{10, 30}, {30, 0}, {10, 22}, {10, 40}} {
+			record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(prices[0]), ExecutionTime: now.Add(time.Duration(i*2) * time.Minute)})
+			record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(prices[1]), ExecutionTime: now.Add(time.Duration(i*2+1) * time.Minute)})
+		}
+
+		times, drawdowns := UnderwaterCurve(record, 100)
+
+		assert.EqualValues(t, []time.Time{
+			now.Add(time.Minute),
+			now.Add(time.Minute * 3),
+			now.Add(time.Minute * 5),
+			now.Add(time.Minute * 7),
+		}, times)
+		assert.InDeltaSlice(t, []float64{0, -0.25, -0.15, 0}, drawdowns, 1e-9)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	t.Run("No trades", func(t *testing.T) {
+		summary := Summarize(NewTradingRecord(), mockTimeSeriesFl(1, 2, 3), 100)
+
+		assert.EqualValues(t, Summary{}, summary)
+	})
+
+	t.Run("Aggregates analyses", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 12, 11, 9, 10, 13)
+		summary := Summarize(mockSummaryRecord(series), series, 100)
+
+		assert.EqualValues(t, 30, summary.TotalProfit)
+		assert.EqualValues(t, 3, summary.NumTrades)
+		assert.InDelta(t, 2.0/3.0, summary.WinRate, 1e-9)
+		assert.EqualValues(t, 2.5, summary.ProfitFactor)
+		assert.EqualValues(t, 25, summary.AverageWin)
+		assert.EqualValues(t, -20, summary.AverageLoss)
+		assert.InDelta(t, -1.0/6.0, summary.MaxDrawdown, 1e-9)
+		assert.InDelta(t, 0.4049, summary.SharpeRatio, 1e-4)
+	})
+}
+
+type failingWriter struct{}
+
+func (fw failingWriter) Write(p []byte) (int, error) {
+	return 0, fmt.Errorf("write failed")
+}
+
+func TestWriteBlotter(t *testing.T) {
+	start := time.Date(2021, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	record := NewTradingRecord()
+	record.Operate(Order{Side: BUY, Security: example, Amount: big.NewFromString("10"), Price: big.NewFromString("101.25"), ExecutionTime: start})
+	record.Operate(Order{Side: SELL, Security: example, Amount: big.NewFromString("10"), Price: big.NewFromString("102.5"), ExecutionTime: start.Add(90 * time.Second)})
+	record.Operate(Order{Side: SELL, Security: example, Amount: big.NewFromString("2.5"), Price: big.NewFromString("103"), ExecutionTime: start.Add(time.Hour)})
+
+	t.Run("writes every order", func(t *testing.T) {
+		var buffer bytes.Buffer
+		assert.NoError(t, WriteBlotter(&buffer, record))
+
+		expected := "55=EXM|54=1|38=10|44=101.25|60=20210601-09:30:00.000\n" +
+			"55=EXM|54=2|38=10|44=102.5|60=20210601-09:31:30.000\n" +
+			"55=EXM|54=2|38=2.5|44=103|60=20210601-10:30:00.000\n"
+
+		assert.EqualValues(t, expected, buffer.String())
+	})
+
+	t.Run("returns write errors", func(t *testing.T) {
+		assert.Error(t, WriteBlotter(failingWriter{}, record))
+	})
+
+	t.Run("writes nothing for empty record", func(t *testing.T) {
+		var buffer bytes.Buffer
+		assert.NoError(t, WriteBlotter(&buffer, NewTradingRecord()))
+
+		assert.Empty(t, buffer.String())
+	})
+}
+
+func TestMonteCarloDrawdown(t *testing.T) {
+	t.Run("Empty record", func(t *testing.T) {
+		median, p95 := MonteCarloDrawdown(NewTradingRecord(), 10, 1)
+
+		assert.EqualValues(t, 0, median)
+		assert.EqualValues(t, 0, p95)
+	})
+
+	t.Run("Invalid iterations", func(t *testing.T) {
+		assert.Panics(t, func() {
+			MonteCarloDrawdown(NewTradingRecord(), 0, 1)
+		})
+	})
+
+	t.Run("Shuffled drawdowns in currency units", func(t *testing.T) {
+		record := NewTradingRecord()
+		for _, prices := range [][2]float64{{1, 2}, {4, 1}, {1, 3}, {2, 1}} {
+			record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(prices[0])})
+			record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(prices[1])})
+		}
+
+		median, p95 := MonteCarloDrawdown(record, 1000, 42)
+
+		assert.True(t, median >= -4 && median <= -3)
+		assert.EqualValues(t, -4, p95)
+		assert.True(t, p95 <= median)
+
+		again, againP95 := MonteCarloDrawdown(record, 1000, 42)
+		assert.EqualValues(t, median, again)
+		assert.EqualValues(t, p95, againP95)
+	})
+
+	t.Run("Does not reorder the record", func(t *testing.T) {
+		record := extremeTradesRecord()
+		first := record.Trades[0]
+
+		MonteCarloDrawdown(record, 10, 1)
+
+		assert.Equal(t, first, record.Trades[0])
+	})
+}
+
+func TestReturnsStatistics(t *testing.T) {
+	t.Run("Fewer than two trades", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11)})
+
+		mean, stddev, skew, kurtosis := ReturnsStatistics(record)
+
+		assert.EqualValues(t, 0, mean)
+		assert.EqualValues(t, 0, stddev)
+		assert.EqualValues(t, 0, skew)
+		assert.EqualValues(t, 0, kurtosis)
+	})
+
+	t.Run("Identical returns", func(t *testing.T) {
+		record := NewTradingRecord()
+		for i := 0; i < 2; i++ {
+			record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+			record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11)})
+		}
+
+		mean, stddev, skew, kurtosis := ReturnsStatistics(record)
+
+		assert.InDelta(t, 0.1, mean, 1e-9)
+		assert.EqualValues(t, 0, stddev)
+		assert.EqualValues(t, 0, skew)
+		assert.EqualValues(t, 0, kurtosis)
+	})
+
+	t.Run("Moments of long and short returns", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		// Returns of 0.1, 0.1, 0.1 and -0.3, the last from a short trade
+		for i := 0; i < 3; i++ {
+			record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+			record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11)})
+		}
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(10)})
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(13)})
+
+		mean, stddev, skew, kurtosis := ReturnsStatistics(record)
+
+		// Deviations from the mean are 0.1, 0.1, 0.1 and -0.3
+		assert.InDelta(t, 0, mean, 1e-9)
+		assert.InDelta(t, math.Sqrt(0.03), stddev, 1e-9)
+		assert.InDelta(t, -0.006/math.Pow(0.03, 1.5), skew, 1e-9)
+		assert.InDelta(t, 0.0021/(0.03*0.03)-3, kurtosis, 1e-9)
+	})
+}
+
+func TestMarkToMarketCurve(t *testing.T) {
+	series := mockTimeSeriesFl(10, 8, 12, 11, 9, 7)
+
+	t.Run("Empty record", func(t *testing.T) {
+		assert.EqualValues(t, []float64{100, 100, 100, 100, 100, 100}, MarkToMarketCurve(NewTradingRecord(), series, 100))
+	})
+
+	t.Run("Marks open positions to each close", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.NewDecimal(2), Price: big.NewDecimal(10), ExecutionTime: series.Candles[0].Period.Start})
+		record.Operate(Order{Side: SELL, Amount: big.NewDecimal(2), Price: big.NewDecimal(12), ExecutionTime: series.Candles[2].Period.Start})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(11), ExecutionTime: series.Candles[3].Period.Start})
+
+		// Long 2 from 10 closed at 12 on candle 2, then short 1 from 11 still open
+		expected := []float64{100, 96, 104, 104, 106, 108}
+		assert.InDeltaSlice(t, expected, MarkToMarketCurve(record, series, 100), 1e-9)
+		assert.Len(t, record.Trades, 1)
+	})
+}
+
+func TestAverageBarsInTrade(t *testing.T) {
+	series := mockTimeSeriesFl(10, 11, 12, 11, 10, 9, 10, 11)
+
+	t.Run("Empty record", func(t *testing.T) {
+		winBars, lossBars := AverageBarsInTrade(NewTradingRecord(), series)
+
+		assert.EqualValues(t, 0, winBars)
+		assert.EqualValues(t, 0, lossBars)
+	})
+
+	t.Run("Winners and losers", func(t *testing.T) {
+		record := NewTradingRecord()
+		for _, bars := range [][2]int{{0, 2}, {2, 5}, {5, 6}} {
+			entry, exit := series.Candles[bars[0]], series.Candles[bars[1]]
+			record.Operate(Order{Side: BUY, Amount: big.ONE, Price: entry.ClosePrice, ExecutionTime: entry.Period.Start})
+			record.Operate(Order{Side: SELL, Amount: big.ONE, Price: exit.ClosePrice, ExecutionTime: exit.Period.Start})
+		}
+
+		winBars, lossBars := AverageBarsInTrade(record, series)
+
+		assert.EqualValues(t, 1.5, winBars)
+		assert.EqualValues(t, 3, lossBars)
+	})
+}
+
+func TestWriteIndicatorsCSV(t *testing.T) {
+	series := mockTimeSeriesFl(1.5, 2, 3.25)
+	closePrice := NewClosePriceIndicator(series)
+	named := map[string]Indicator{
+		"sma": NewSimpleMovingAverage(closePrice, 2),
+		"ad":  NewDifferenceIndicator(closePrice, NewConstantIndicator(1)),
+	}
+
+	t.Run("writes a row per candle", func(t *testing.T) {
+		var buffer bytes.Buffer
+		assert.NoError(t, WriteIndicatorsCSV(&buffer, series, named))
+
+		timestamp := func(i int) string {
+			return series.Candles[i].Period.Start.Format(time.RFC3339)
+		}
+
+		expected := "time,close,ad,sma\n" +
+			timestamp(0) + ",1.5,0.5,0\n" +
+			timestamp(1) + ",2,1,1.75\n" +
+			timestamp(2) + ",3.25,2.25,2.625\n"
+
+		assert.EqualValues(t, expected, buffer.String())
+	})
+
+	t.Run("returns write errors", func(t *testing.T) {
+		assert.Error(t, WriteIndicatorsCSV(failingWriter{}, series, named))
+	})
+}
+
+func TestEquityCurve(t *testing.T) {
+	record := NewTradingRecord()
+	for _, prices := range [][2]float64{{10, 12}, {10, 8}, {10, 15}} {
+		record.Operate(Order{Side: BUY, Amount: big.TEN, Price: big.NewDecimal(prices[0])})
+		record.Operate(Order{Side: SELL, Amount: big.TEN, Price: big.NewDecimal(prices[1])})
+	}
+
+	t.Run("Fixed size", func(t *testing.T) {
+		assert.InDeltaSlice(t, []float64{100, 120, 100, 150}, EquityCurve(record, 100, FixedSize), 1e-9)
+	})
+
+	t.Run("Compounding", func(t *testing.T) {
+		assert.InDeltaSlice(t, []float64{100, 120, 96, 144}, EquityCurve(record, 100, Compounding), 1e-9)
+	})
+}
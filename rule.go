@@ -1,8 +1,14 @@
 package techan
 
-import "github.com/sdcoffey/big"
+import (
+	"fmt"
 
-// Rule is an interface describing an algorithm by which a set of criteria may be satisfied
+	"github.com/sdcoffey/big"
+)
+
+// Rule is an interface describing an algorithm by which a set of criteria may be satisfied. Some rules, such as those
+// returned by NewOnceRule, NewDelayedRule, NewProfitLockRule and NewPriorityExitRule, remember earlier calls to
+// IsSatisfied, so they should not be shared between strategies or trading records.
 type Rule interface {
 	IsSatisfied(index int, record *TradingRecord) bool
 }
@@ -21,6 +27,64 @@ func Not(r1 Rule) Rule {
 	return notRule{r1}
 }
 
+// OnBarClose returns a new rule that evaluates the passed-in rule against the last completed bar rather than the current
+// one. When a strategy is run on a live or intrabar feed, the candle at index is still forming, and a cross or
+// threshold rule satisfied by its current close may no longer be satisfied once the bar closes. Wrapping such a rule
+// with OnBarClose makes it satisfied at index only if it was satisfied at index-1, whose candle has closed, so signals
+// never depend on a partial bar. The wrapped rule is never satisfied at index 0. Rules that are not wrapped keep
+// evaluating the current bar.
+func OnBarClose(rule Rule) Rule {
+	return barCloseRule{rule}
+}
+
+// NewAtLeastRule returns a new rule whereby at least k of the passed-in rules must be satisfied for the rule to be
+// satisfied. NewAtLeastRule panics if k is less than 1 or greater than the number of rules.
+func NewAtLeastRule(k int, rules ...Rule) Rule {
+	if k < 1 || k > len(rules) {
+		panic(fmt.Errorf("error creating at least rule: k must be between 1 and %d, got %d", len(rules), k))
+	}
+
+	return atLeastRule{
+		k:     k,
+		rules: rules,
+	}
+}
+
+// WeightedRule pairs a Rule with the weight it contributes to a weighted signal rule when satisfied
+type WeightedRule struct {
+	Rule   Rule
+	Weight float64
+}
+
+// NewWeightedSignalRule returns a new rule whereby the weights of the satisfied rules must add up to at least threshold
+// for the rule to be satisfied. It generalizes NewAtLeastRule, which weighs every rule as 1, to scoring systems in
+// which some confirmations count for more than others. Rules are evaluated in the order they were given.
+// NewWeightedSignalRule panics if any weight is negative.
+func NewWeightedSignalRule(threshold float64, rules ...WeightedRule) Rule {
+	for _, wr := range rules {
+		if wr.Weight < 0 {
+			panic(fmt.Errorf("error creating weighted signal rule: weights must not be negative, got %v", wr.Weight))
+		}
+	}
+
+	return weightedSignalRule{
+		threshold: threshold,
+		rules:     rules,
+	}
+}
+
+type barCloseRule struct {
+	rule Rule
+}
+
+func (bcr barCloseRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index < 1 {
+		return false
+	}
+
+	return bcr.rule.IsSatisfied(index-1, record)
+}
+
 type andRule struct {
 	r1 Rule
 	r2 Rule
@@ -39,6 +103,41 @@ func (or orRule) IsSatisfied(index int, record *TradingRecord) bool {
 	return or.r1.IsSatisfied(index, record) || or.r2.IsSatisfied(index, record)
 }
 
+type atLeastRule struct {
+	k     int
+	rules []Rule
+}
+
+func (alr atLeastRule) IsSatisfied(index int, record *TradingRecord) bool {
+	var satisfied int
+	for _, rule := range alr.rules {
+		if rule.IsSatisfied(index, record) {
+			satisfied++
+			if satisfied >= alr.k {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type weightedSignalRule struct {
+	threshold float64
+	rules     []WeightedRule
+}
+
+func (wsr weightedSignalRule) IsSatisfied(index int, record *TradingRecord) bool {
+	var total float64
+	for _, wr := range wsr.rules {
+		if wr.Rule.IsSatisfied(index, record) {
+			total += wr.Weight
+		}
+	}
+
+	return total >= wsr.threshold
+}
+
 type notRule struct {
 	r1 Rule
 }
@@ -86,3 +185,126 @@ func NewPercentChangeRule(indicator Indicator, percent float64) Rule {
 		percent:   big.NewDecimal(percent),
 	}
 }
+
+type nearRule struct {
+	price     Indicator
+	reference Indicator
+	tolerance big.Decimal
+}
+
+func (nr nearRule) IsSatisfied(index int, record *TradingRecord) bool {
+	reference := nr.reference.Calculate(index)
+	if reference.IsZero() {
+		return false
+	}
+
+	distance := nr.price.Calculate(index).Sub(reference).Div(reference).Abs()
+	return distance.LTE(nr.tolerance)
+}
+
+// NewNearRule returns a rule whereby the price Indicator must be within a given percentage of the reference Indicator,
+// above or below it, to be satisfied. It's never satisfied while the reference is zero. You should specify tolerance as
+// a float value between 0 and 1
+func NewNearRule(price, reference Indicator, tolerance float64) Rule {
+	return nearRule{
+		price:     price,
+		reference: reference,
+		tolerance: big.NewDecimal(tolerance),
+	}
+}
+
+type separationRule struct {
+	a          Indicator
+	b          Indicator
+	minPercent big.Decimal
+}
+
+func (sr separationRule) IsSatisfied(index int, record *TradingRecord) bool {
+	b := sr.b.Calculate(index)
+	if b.IsZero() {
+		return false
+	}
+
+	separation := sr.a.Calculate(index).Sub(b).Div(b.Abs())
+	if sr.minPercent.LT(big.ZERO) {
+		return separation.LTE(sr.minPercent)
+	}
+
+	return separation.GTE(sr.minPercent)
+}
+
+// NewSeparationRule returns a rule whereby Indicator a must exceed Indicator b by at least minPercent of b's magnitude
+// to be satisfied. A negative minPercent instead requires a to be below b by at least that much. It's never satisfied
+// while b is zero. You should specify minPercent as a float value between -1 and 1
+func NewSeparationRule(a, b Indicator, minPercent float64) Rule {
+	return separationRule{
+		a:          a,
+		b:          b,
+		minPercent: big.NewDecimal(minPercent),
+	}
+}
+
+// NewTrendFilterRule returns a rule whereby the close price of the series must be above its EMA over emaWindow (long)
+// or below it (!long) to be satisfied. The rule is not satisfied while the EMA is warming up, i.e., before emaWindow
+// candles are available. Combine it with an entry rule using And to only trade in the direction of the trend.
+func NewTrendFilterRule(series *TimeSeries, emaWindow int, long bool) Rule {
+	closePrice := NewClosePriceIndicator(series)
+	ema := NewEMAIndicator(closePrice, emaWindow)
+
+	var rule Rule = UnderIndicatorRule{First: closePrice, Second: ema}
+	if long {
+		rule = OverIndicatorRule{First: closePrice, Second: ema}
+	}
+
+	return trendFilterRule{
+		rule:   rule,
+		warmup: emaWindow - 1,
+	}
+}
+
+type trendFilterRule struct {
+	rule   Rule
+	warmup int
+}
+
+func (tfr trendFilterRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index < tfr.warmup {
+		return false
+	}
+
+	return tfr.rule.IsSatisfied(index, record)
+}
+
+// PriorityExitRule combines several exit rules, e.g., a stop, a target and a time exit, and records which of them
+// caused the exit. Rules are evaluated in the order they were given, and the first one satisfied wins.
+type PriorityExitRule struct {
+	rules         []Rule
+	lastTriggered int
+}
+
+// NewPriorityExitRule returns a new PriorityExitRule which is satisfied whenever one of the passed-in rules is satisfied.
+func NewPriorityExitRule(rules ...Rule) *PriorityExitRule {
+	return &PriorityExitRule{
+		rules:         rules,
+		lastTriggered: -1,
+	}
+}
+
+// IsSatisfied returns true if any of the rules is satisfied, evaluating them in order and stopping at the first that is
+func (per *PriorityExitRule) IsSatisfied(index int, record *TradingRecord) bool {
+	for i, rule := range per.rules {
+		if rule.IsSatisfied(index, record) {
+			per.lastTriggered = i
+			return true
+		}
+	}
+
+	return false
+}
+
+// LastTriggered returns the position, in the order given to NewPriorityExitRule, of the rule that satisfied the most
+// recent call to IsSatisfied that returned true, or -1 if it has never been satisfied. Calls that return false leave it
+// unchanged, so it still attributes the last exit after the position has closed.
+func (per *PriorityExitRule) LastTriggered() int {
+	return per.lastTriggered
+}
@@ -0,0 +1,42 @@
+package techan
+
+type newExtremeRule struct {
+	series *TimeSeries
+	window int
+	cmp    int
+}
+
+// NewNewHighRule returns a new rule that is satisfied when the close price of the current candle is higher than the
+// close price of each of the preceding window candles. The rule is not satisfied until window preceding candles exist.
+func NewNewHighRule(series *TimeSeries, window int) Rule {
+	return newExtremeRule{
+		series: series,
+		window: window,
+		cmp:    1,
+	}
+}
+
+// NewNewLowRule returns a new rule that is satisfied when the close price of the current candle is lower than the
+// close price of each of the preceding window candles. The rule is not satisfied until window preceding candles exist.
+func NewNewLowRule(series *TimeSeries, window int) Rule {
+	return newExtremeRule{
+		series: series,
+		window: window,
+		cmp:    -1,
+	}
+}
+
+func (ner newExtremeRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index < ner.window || ner.window < 1 {
+		return false
+	}
+
+	close := ner.series.Candles[index].ClosePrice
+	for i := index - ner.window; i < index; i++ {
+		if close.Cmp(ner.series.Candles[i].ClosePrice) != ner.cmp {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,27 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHighRule(t *testing.T) {
+	series := mockTimeSeriesFl(5, 3, 4, 6, 6, 7, 2)
+	rule := NewNewHighRule(series, 3)
+
+	expected := []bool{false, false, false, true, false, true, false}
+	for i, want := range expected {
+		assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+	}
+}
+
+func TestNewLowRule(t *testing.T) {
+	series := mockTimeSeriesFl(5, 7, 6, 4, 4, 3, 8)
+	rule := NewNewLowRule(series, 3)
+
+	expected := []bool{false, false, false, true, false, true, false}
+	for i, want := range expected {
+		assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+	}
+}
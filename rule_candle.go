@@ -0,0 +1,145 @@
+package techan
+
+import (
+	"fmt"
+
+	"github.com/sdcoffey/big"
+)
+
+type gapRule struct {
+	series     *TimeSeries
+	minGap     big.Decimal
+	multiplier big.Decimal
+}
+
+// NewGapRule returns a new rule that is satisfied when the open price of the current candle is more than
+// minGapPercent above (up) or below (!up) the close price of the previous candle. You should specify minGapPercent as a
+// float value between 0 and 1.
+func NewGapRule(series *TimeSeries, minGapPercent float64, up bool) Rule {
+	multiplier := big.ONE
+	if !up {
+		multiplier = big.ONE.Neg()
+	}
+
+	return gapRule{
+		series:     series,
+		minGap:     big.NewDecimal(minGapPercent),
+		multiplier: multiplier,
+	}
+}
+
+func (gr gapRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index == 0 {
+		return false
+	}
+
+	prevClose := gr.series.Candles[index-1].ClosePrice
+	open := gr.series.Candles[index].OpenPrice
+	gap := SafeDivide(open.Sub(prevClose), prevClose, big.ZERO).Mul(gr.multiplier)
+
+	return gap.GT(gr.minGap)
+}
+
+type consecutiveCandlesRule struct {
+	series *TimeSeries
+	count  int
+	up     bool
+}
+
+// NewConsecutiveCandlesRule returns a new rule that is satisfied when the current candle and the count-1 candles before
+// it all closed above their open price (up), or all closed below it (!up). It's never satisfied before count candles
+// are available. NewConsecutiveCandlesRule panics if count is less than 1.
+func NewConsecutiveCandlesRule(series *TimeSeries, count int, up bool) Rule {
+	if count < 1 {
+		panic(fmt.Errorf("error creating consecutive candles rule: count must be at least 1, got %d", count))
+	}
+
+	return consecutiveCandlesRule{
+		series: series,
+		count:  count,
+		up:     up,
+	}
+}
+
+func (ccr consecutiveCandlesRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index < ccr.count-1 {
+		return false
+	}
+
+	for i := index - ccr.count + 1; i <= index; i++ {
+		candle := ccr.series.Candles[i]
+		if ccr.up && !candle.ClosePrice.GT(candle.OpenPrice) {
+			return false
+		}
+		if !ccr.up && !candle.ClosePrice.LT(candle.OpenPrice) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type volumeSpikeRule struct {
+	series        *TimeSeries
+	averageVolume Indicator
+	window        int
+	multiplier    big.Decimal
+}
+
+// NewVolumeSpikeRule returns a new rule that is satisfied when the volume of the current candle is more than multiplier
+// times the average volume of the window candles before it. The rule is not satisfied until window preceding candles
+// exist.
+func NewVolumeSpikeRule(series *TimeSeries, window int, multiplier float64) Rule {
+	return volumeSpikeRule{
+		series:        series,
+		averageVolume: NewSimpleMovingAverage(NewVolumeIndicator(series), window),
+		window:        window,
+		multiplier:    big.NewDecimal(multiplier),
+	}
+}
+
+func (vsr volumeSpikeRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index < vsr.window || vsr.window < 1 {
+		return false
+	}
+
+	threshold := vsr.averageVolume.Calculate(index - 1).Mul(vsr.multiplier)
+	return vsr.series.Candles[index].Volume.GT(threshold)
+}
+
+type rangeBarRule struct {
+	series *TimeSeries
+	inside bool
+}
+
+// NewInsideBarRule returns a new rule that is satisfied when the current candle's high is below the previous candle's
+// high and its low is above the previous candle's low, a sign of consolidation. The rule is not satisfied at index 0.
+func NewInsideBarRule(series *TimeSeries) Rule {
+	return rangeBarRule{
+		series: series,
+		inside: true,
+	}
+}
+
+// NewOutsideBarRule returns a new rule that is satisfied when the current candle's high is above the previous candle's
+// high and its low is below the previous candle's low, engulfing its range. The rule is not satisfied at index 0.
+func NewOutsideBarRule(series *TimeSeries) Rule {
+	return rangeBarRule{
+		series: series,
+	}
+}
+
+func (rbr rangeBarRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index == 0 {
+		return false
+	}
+
+	prev := rbr.series.Candles[index-1]
+	candle := rbr.series.Candles[index]
+
+	if rbr.inside {
+		return candle.MaxPrice.LT(prev.MaxPrice) && candle.MinPrice.GT(prev.MinPrice)
+	}
+
+	return candle.MaxPrice.GT(prev.MaxPrice) && candle.MinPrice.LT(prev.MinPrice)
+}
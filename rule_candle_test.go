@@ -0,0 +1,129 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGapRule(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 10, 11, 9},
+		[]float64{10.5, 11, 11, 10},
+		[]float64{12, 12, 12, 11},
+		[]float64{10, 11, 12, 10},
+	)
+
+	t.Run("Returns false at index 0", func(t *testing.T) {
+		assert.False(t, NewGapRule(series, 0, true).IsSatisfied(0, nil))
+		assert.False(t, NewGapRule(series, 0, false).IsSatisfied(0, nil))
+	})
+
+	t.Run("Gap up", func(t *testing.T) {
+		rule := NewGapRule(series, 0.05, true)
+
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("Gap down", func(t *testing.T) {
+		rule := NewGapRule(series, 0.1, false)
+
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.True(t, rule.IsSatisfied(3, nil))
+	})
+}
+
+func TestConsecutiveCandlesRule(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 11, 11, 10},
+		[]float64{11, 12, 12, 11},
+		[]float64{12, 13, 13, 12},
+		[]float64{13, 13, 13, 13},
+		[]float64{13, 12, 13, 12},
+		[]float64{12, 11, 12, 11},
+	)
+
+	t.Run("Returns false near the start of the series", func(t *testing.T) {
+		rule := NewConsecutiveCandlesRule(series, 3, true)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+	})
+
+	t.Run("Up candles", func(t *testing.T) {
+		rule := NewConsecutiveCandlesRule(series, 3, true)
+
+		assert.True(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("Down candles", func(t *testing.T) {
+		rule := NewConsecutiveCandlesRule(series, 2, false)
+
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(4, nil))
+		assert.True(t, rule.IsSatisfied(5, nil))
+	})
+
+	t.Run("Panics with invalid count", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewConsecutiveCandlesRule(series, 0, true)
+		})
+	})
+}
+
+func TestVolumeSpikeRule(t *testing.T) {
+	// Volume is the candle's index
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 10, 10, 10},
+		[]float64{10, 10, 10, 10},
+		[]float64{10, 10, 10, 10},
+		[]float64{10, 10, 10, 10},
+		[]float64{10, 10, 10, 10},
+	)
+
+	t.Run("Returns false before window candles exist", func(t *testing.T) {
+		assert.False(t, NewVolumeSpikeRule(series, 3, 0).IsSatisfied(2, nil))
+	})
+
+	t.Run("Compares against trailing average volume", func(t *testing.T) {
+		rule := NewVolumeSpikeRule(series, 2, 2)
+
+		assert.True(t, rule.IsSatisfied(2, nil))  // 2 > 2 * 0.5
+		assert.False(t, rule.IsSatisfied(3, nil)) // 3 = 2 * 1.5
+		assert.False(t, rule.IsSatisfied(4, nil)) // 4 < 2 * 2.5
+	})
+}
+
+func TestInsideBarRule(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 10, 12, 8},
+		[]float64{10, 10, 11, 9},
+		[]float64{10, 10, 11, 8},
+		[]float64{10, 10, 12, 7},
+	)
+	rule := NewInsideBarRule(series)
+
+	assert.False(t, rule.IsSatisfied(0, nil))
+	assert.True(t, rule.IsSatisfied(1, nil))
+	assert.False(t, rule.IsSatisfied(2, nil))
+	assert.False(t, rule.IsSatisfied(3, nil))
+}
+
+func TestOutsideBarRule(t *testing.T) {
+	series := mockTimeSeriesOCHL(
+		[]float64{10, 10, 11, 9},
+		[]float64{10, 10, 12, 8},
+		[]float64{10, 10, 13, 8},
+		[]float64{10, 10, 12, 9},
+	)
+	rule := NewOutsideBarRule(series)
+
+	assert.False(t, rule.IsSatisfied(0, nil))
+	assert.True(t, rule.IsSatisfied(1, nil))
+	assert.False(t, rule.IsSatisfied(2, nil))
+	assert.False(t, rule.IsSatisfied(3, nil))
+}
@@ -0,0 +1,47 @@
+package techan
+
+import "fmt"
+
+type delayedRule struct {
+	rule    Rule
+	delay   int
+	pending int
+	last    int
+}
+
+// NewDelayedRule returns a new rule that is satisfied delay bars after the wrapped rule is satisfied, ignoring further
+// signals until then. Evaluate it on every index in order: skipping the index a signal would fire on drops it, and
+// going back resets the rule. NewDelayedRule panics if delay is less than 1.
+func NewDelayedRule(rule Rule, delay int) Rule {
+	if delay < 1 {
+		panic(fmt.Errorf("error creating delayed rule: delay must be at least 1, got %d", delay))
+	}
+
+	return &delayedRule{
+		rule:    rule,
+		delay:   delay,
+		pending: -1,
+		last:    -1,
+	}
+}
+
+func (dr *delayedRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index <= dr.last {
+		dr.pending = -1
+	}
+	dr.last = index
+
+	if dr.pending >= 0 && index-dr.pending >= dr.delay {
+		due := index-dr.pending == dr.delay
+		dr.pending = -1
+		if due {
+			return true
+		}
+	}
+
+	if dr.pending < 0 && dr.rule.IsSatisfied(index, record) {
+		dr.pending = index
+	}
+
+	return false
+}
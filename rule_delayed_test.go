@@ -0,0 +1,55 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelayedRule(t *testing.T) {
+	signals := func(values ...float64) Rule {
+		return OverIndicatorRule{First: NewFixedIndicator(values...), Second: NewConstantIndicator(0)}
+	}
+
+	t.Run("fires delay bars after the signal", func(t *testing.T) {
+		rule := NewDelayedRule(signals(0, 1, 0, 0, 0), 2)
+
+		expected := []bool{false, false, false, true, false}
+		for i, want := range expected {
+			assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+		}
+	})
+
+	t.Run("honors the earliest signal in the delay window", func(t *testing.T) {
+		rule := NewDelayedRule(signals(1, 1, 1, 0, 1, 0, 0), 2)
+
+		expected := []bool{false, false, true, false, false, false, true}
+		for i, want := range expected {
+			assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+		}
+	})
+
+	t.Run("drops a signal whose firing bar is skipped", func(t *testing.T) {
+		rule := NewDelayedRule(signals(1, 0, 0, 0), 1)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("resets when evaluated from the start again", func(t *testing.T) {
+		rule := NewDelayedRule(signals(0, 1, 0), 1)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+	})
+
+	t.Run("panics with invalid delay", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewDelayedRule(truthRule{}, 0)
+		})
+	})
+}
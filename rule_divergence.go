@@ -0,0 +1,62 @@
+package techan
+
+type divergenceRule struct {
+	price      Indicator
+	oscillator Indicator
+	lookback   int
+	side       int
+}
+
+// NewBullishDivergenceRule returns a new rule that is satisfied when the price indicator makes a lower low while the
+// oscillator makes a higher low. Lows are pivot lows of the price indicator: values lower than the value before them
+// and no higher than the value after them. The rule is satisfied at the index that confirms a new pivot low (i.e., the
+// pivot is at index-1) when the price of that pivot is below the price of the previous pivot low no more than
+// lookback bars before it, and the oscillator at the new pivot is above the oscillator at the previous one.
+func NewBullishDivergenceRule(priceIndicator, oscillatorIndicator Indicator, lookback int) Rule {
+	return divergenceRule{
+		price:      priceIndicator,
+		oscillator: oscillatorIndicator,
+		lookback:   lookback,
+		side:       -1,
+	}
+}
+
+// NewBearishDivergenceRule returns a new rule that is satisfied when the price indicator makes a higher high while the
+// oscillator makes a lower high. Highs are pivot highs of the price indicator, defined and compared as the pivot lows
+// of NewBullishDivergenceRule are, with each comparison reversed.
+func NewBearishDivergenceRule(priceIndicator, oscillatorIndicator Indicator, lookback int) Rule {
+	return divergenceRule{
+		price:      priceIndicator,
+		oscillator: oscillatorIndicator,
+		lookback:   lookback,
+		side:       1,
+	}
+}
+
+func (dr divergenceRule) IsSatisfied(index int, record *TradingRecord) bool {
+	pivot := index - 1
+	if pivot < 1 || !dr.isPivot(pivot) {
+		return false
+	}
+
+	for prev := pivot - 1; prev >= Max(pivot-dr.lookback, 1); prev-- {
+		if !dr.isPivot(prev) {
+			continue
+		}
+
+		priceCmp := dr.price.Calculate(pivot).Cmp(dr.price.Calculate(prev))
+		oscillatorCmp := dr.oscillator.Calculate(pivot).Cmp(dr.oscillator.Calculate(prev))
+
+		return priceCmp == dr.side && oscillatorCmp == -dr.side
+	}
+
+	return false
+}
+
+// isPivot returns true when the price at index is beyond the price before it, and the price after it is not beyond it,
+// where beyond means lower for bullish rules and higher for bearish ones
+func (dr divergenceRule) isPivot(index int) bool {
+	value := dr.price.Calculate(index)
+
+	return value.Cmp(dr.price.Calculate(index-1)) == dr.side && dr.price.Calculate(index+1).Cmp(value) != dr.side
+}
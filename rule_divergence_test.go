@@ -0,0 +1,47 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBullishDivergenceRule(t *testing.T) {
+	price := NewFixedIndicator(10, 8, 9, 7, 8)
+
+	t.Run("returns true when price makes a lower low and oscillator a higher low", func(t *testing.T) {
+		rule := NewBullishDivergenceRule(price, NewFixedIndicator(50, 30, 40, 35, 45), 5)
+
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+		assert.True(t, rule.IsSatisfied(4, nil))
+	})
+
+	t.Run("returns false when oscillator confirms the lower low", func(t *testing.T) {
+		rule := NewBullishDivergenceRule(price, NewFixedIndicator(50, 30, 40, 25, 45), 5)
+
+		assert.False(t, rule.IsSatisfied(4, nil))
+	})
+
+	t.Run("returns false when previous low is outside lookback", func(t *testing.T) {
+		rule := NewBullishDivergenceRule(price, NewFixedIndicator(50, 30, 40, 35, 45), 1)
+
+		assert.False(t, rule.IsSatisfied(4, nil))
+	})
+}
+
+func TestBearishDivergenceRule(t *testing.T) {
+	price := NewFixedIndicator(10, 12, 11, 13, 12)
+
+	t.Run("returns true when price makes a higher high and oscillator a lower high", func(t *testing.T) {
+		rule := NewBearishDivergenceRule(price, NewFixedIndicator(50, 70, 60, 65, 55), 5)
+
+		assert.True(t, rule.IsSatisfied(4, nil))
+	})
+
+	t.Run("returns false when oscillator confirms the higher high", func(t *testing.T) {
+		rule := NewBearishDivergenceRule(price, NewFixedIndicator(50, 70, 60, 75, 55), 5)
+
+		assert.False(t, rule.IsSatisfied(4, nil))
+	})
+}
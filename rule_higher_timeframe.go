@@ -0,0 +1,44 @@
+package techan
+
+import "sort"
+
+type higherTimeframeRule struct {
+	htfIndicator Indicator
+	htfSeries    *TimeSeries
+	ltfSeries    *TimeSeries
+	innerRule    func(htfIndex int) bool
+}
+
+// NewHigherTimeframeRule returns a new rule for evaluating a higher timeframe condition while stepping through a lower
+// timeframe series. The index passed to IsSatisfied is an index into ltfSeries; it's mapped to the last candle in
+// htfSeries which closed no later than the lower timeframe candle, and innerRule is called with that index. Only closed
+// higher timeframe candles are considered, so no information from the future leaks into the lower timeframe. The rule
+// is not satisfied if no higher timeframe candle has closed yet.
+//
+// htfIndicator is the higher timeframe indicator that innerRule evaluates, e.g. a daily EMA. The rule is not satisfied,
+// and innerRule is not called, while htfIndicator is big.NaN at the mapped index, e.g. while it's still warming up.
+func NewHigherTimeframeRule(htfIndicator Indicator, htfSeries *TimeSeries, ltfSeries *TimeSeries, innerRule func(htfIndex int) bool) Rule {
+	return higherTimeframeRule{
+		htfIndicator: htfIndicator,
+		htfSeries:    htfSeries,
+		ltfSeries:    ltfSeries,
+		innerRule:    innerRule,
+	}
+}
+
+func (htfr higherTimeframeRule) IsSatisfied(index int, record *TradingRecord) bool {
+	htfIndex := htfr.higherTimeframeIndex(index)
+	if htfIndex < 0 || htfr.htfIndicator.Calculate(htfIndex).NaN() {
+		return false
+	}
+
+	return htfr.innerRule(htfIndex)
+}
+
+func (htfr higherTimeframeRule) higherTimeframeIndex(ltfIndex int) int {
+	ltfEnd := htfr.ltfSeries.Candles[ltfIndex].Period.End
+
+	return sort.Search(len(htfr.htfSeries.Candles), func(i int) bool {
+		return htfr.htfSeries.Candles[i].Period.End.After(ltfEnd)
+	}) - 1
+}
@@ -0,0 +1,63 @@
+package techan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHigherTimeframeRule(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	htfSeries := NewTimeSeries()
+	for i, price := range []float64{10, 20} {
+		candle := NewCandle(NewTimePeriod(start.Add(time.Duration(i)*time.Hour*4), time.Hour*4))
+		candle.ClosePrice = big.NewDecimal(price)
+		htfSeries.AddCandle(candle)
+	}
+
+	ltfSeries := NewTimeSeries()
+	for i := 0; i < 10; i++ {
+		candle := NewCandle(NewTimePeriod(start.Add(time.Duration(i)*time.Hour), time.Hour))
+		ltfSeries.AddCandle(candle)
+	}
+
+	htfClose := NewClosePriceIndicator(htfSeries)
+
+	var seen []int
+	rule := NewHigherTimeframeRule(htfClose, htfSeries, ltfSeries, func(htfIndex int) bool {
+		seen = append(seen, htfIndex)
+		return htfClose.Calculate(htfIndex).GT(big.NewDecimal(15))
+	})
+
+	t.Run("Returns false before the first higher timeframe candle closes", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.Empty(t, seen)
+	})
+
+	t.Run("Uses the last closed higher timeframe candle", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(3, nil))
+		assert.False(t, rule.IsSatisfied(6, nil))
+		assert.True(t, rule.IsSatisfied(7, nil))
+		assert.True(t, rule.IsSatisfied(9, nil))
+		assert.EqualValues(t, []int{0, 0, 1, 1}, seen)
+	})
+
+	t.Run("Returns false while the higher timeframe indicator is warming up", func(t *testing.T) {
+		htfSMA := NewSimpleMovingAverageWithWarmup(htfClose, 2, SMAWarmupNaN)
+
+		var warm []int
+		rule := NewHigherTimeframeRule(htfSMA, htfSeries, ltfSeries, func(htfIndex int) bool {
+			warm = append(warm, htfIndex)
+			return htfSMA.Calculate(htfIndex).GT(big.NewDecimal(10))
+		})
+
+		assert.False(t, rule.IsSatisfied(3, nil))
+		assert.False(t, rule.IsSatisfied(6, nil))
+		assert.True(t, rule.IsSatisfied(7, nil))
+		assert.EqualValues(t, []int{1}, warm)
+	})
+}
@@ -31,3 +31,44 @@ func (dr DecreaseRule) IsSatisfied(index int, record *TradingRecord) bool {
 
 	return dr.Calculate(index).LT(dr.Calculate(index - 1))
 }
+
+type trendRule struct {
+	indicator Indicator
+	bars      int
+	cmp       int
+}
+
+// NewRisingRule returns a new rule that is satisfied when the given Indicator has increased at each of the last bars
+// steps, e.g., for bars = 3, when each of the latest four values is greater than the one before it. It is not
+// satisfied until bars previous values exist.
+func NewRisingRule(indicator Indicator, bars int) Rule {
+	return trendRule{
+		indicator: indicator,
+		bars:      bars,
+		cmp:       1,
+	}
+}
+
+// NewFallingRule returns a new rule that is satisfied when the given Indicator has decreased at each of the last bars
+// steps. It is not satisfied until bars previous values exist.
+func NewFallingRule(indicator Indicator, bars int) Rule {
+	return trendRule{
+		indicator: indicator,
+		bars:      bars,
+		cmp:       -1,
+	}
+}
+
+func (tr trendRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if tr.bars < 1 || index < tr.bars {
+		return false
+	}
+
+	for i := index; i > index-tr.bars; i-- {
+		if tr.indicator.Calculate(i).Cmp(tr.indicator.Calculate(i-1)) != tr.cmp {
+			return false
+		}
+	}
+
+	return true
+}
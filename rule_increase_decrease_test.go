@@ -63,3 +63,21 @@ func TestDecreaseRule(t *testing.T) {
 		assert.False(t, rule.IsSatisfied(1, nil))
 	})
 }
+
+func TestRisingRule(t *testing.T) {
+	rule := NewRisingRule(NewFixedIndicator(1, 2, 3, 4, 4, 5, 6, 7), 3)
+
+	expected := []bool{false, false, false, true, false, false, false, true}
+	for i, want := range expected {
+		assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+	}
+}
+
+func TestFallingRule(t *testing.T) {
+	rule := NewFallingRule(NewFixedIndicator(7, 6, 5, 4, 5, 4, 3, 2), 3)
+
+	expected := []bool{false, false, false, true, false, false, false, true}
+	for i, want := range expected {
+		assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+	}
+}
@@ -0,0 +1,41 @@
+package techan
+
+type onceRule struct {
+	rule  Rule
+	key   positionKey
+	fired bool
+}
+
+// NewOnceRule returns a new rule that is satisfied only the first time the wrapped rule is satisfied during each
+// position, treating the time between positions as a position of its own.
+func NewOnceRule(rule Rule) Rule {
+	return &onceRule{rule: rule}
+}
+
+func (or *onceRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if key := currentPositionKey(record); key != or.key {
+		or.key = key
+		or.fired = false
+	}
+
+	if or.fired || !or.rule.IsSatisfied(index, record) {
+		return false
+	}
+
+	or.fired = true
+	return true
+}
+
+// positionKey identifies the current position of a record by the number of trades closed before it and whether it's
+// open, so that positions are told apart even when their orders share an execution time
+type positionKey struct {
+	trades int
+	open   bool
+}
+
+func currentPositionKey(record *TradingRecord) positionKey {
+	return positionKey{
+		trades: len(record.Trades),
+		open:   record.CurrentPosition().IsOpen(),
+	}
+}
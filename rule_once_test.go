@@ -0,0 +1,56 @@
+package techan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnceRule(t *testing.T) {
+	now := time.Now()
+	record := NewTradingRecord()
+	rule := NewOnceRule(truthRule{})
+
+	t.Run("fires once while flat", func(t *testing.T) {
+		assert.True(t, rule.IsSatisfied(0, record))
+		assert.False(t, rule.IsSatisfied(1, record))
+	})
+
+	t.Run("resets when a position opens", func(t *testing.T) {
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: now})
+
+		assert.True(t, rule.IsSatisfied(2, record))
+		assert.False(t, rule.IsSatisfied(3, record))
+	})
+
+	t.Run("resets when the position closes and another opens", func(t *testing.T) {
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Minute)})
+		assert.True(t, rule.IsSatisfied(4, record))
+
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: now.Add(time.Minute * 2)})
+		assert.True(t, rule.IsSatisfied(5, record))
+		assert.False(t, rule.IsSatisfied(6, record))
+	})
+
+	t.Run("resets between positions without execution times", func(t *testing.T) {
+		record := NewTradingRecord()
+		rule := NewOnceRule(truthRule{})
+
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE})
+		assert.True(t, rule.IsSatisfied(0, record))
+		assert.False(t, rule.IsSatisfied(1, record))
+
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.ONE})
+		assert.True(t, rule.IsSatisfied(2, record))
+
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE})
+		assert.True(t, rule.IsSatisfied(3, record))
+		assert.False(t, rule.IsSatisfied(4, record))
+	})
+
+	t.Run("does not fire when the wrapped rule is unsatisfied", func(t *testing.T) {
+		assert.False(t, NewOnceRule(falseRule{}).IsSatisfied(0, record))
+	})
+}
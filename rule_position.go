@@ -1,5 +1,7 @@
 package techan
 
+import "fmt"
+
 // PositionNewRule is satisfied when the current position in the trading record is new (no
 // open positions).
 type PositionNewRule struct{}
@@ -17,3 +19,106 @@ type PositionOpenRule struct{}
 func (pnr PositionOpenRule) IsSatisfied(index int, record *TradingRecord) bool {
 	return record.CurrentPosition().IsOpen()
 }
+
+type maxOpenPositionsRule struct {
+	portfolio *Portfolio
+	max       int
+}
+
+// NewMaxOpenPositionsRule returns a new rule that is satisfied while fewer than max positions are open across the
+// portfolio. Combine it with an entry rule to cap the number of concurrently open positions.
+func NewMaxOpenPositionsRule(portfolio *Portfolio, max int) Rule {
+	return maxOpenPositionsRule{
+		portfolio: portfolio,
+		max:       max,
+	}
+}
+
+func (mopr maxOpenPositionsRule) IsSatisfied(index int, record *TradingRecord) bool {
+	return mopr.portfolio.OpenPositions() < mopr.max
+}
+
+type forceCloseAtEndRule struct {
+	series *TimeSeries
+}
+
+// NewForceCloseAtEndRule returns a new rule that is satisfied at the last index of the series while a position is
+// open. Combine it with an exit rule using Or so that a backtest flattens any open position at the final close, and
+// the analyses of the resulting trading record account for it.
+func NewForceCloseAtEndRule(series *TimeSeries) Rule {
+	return forceCloseAtEndRule{series: series}
+}
+
+func (fcer forceCloseAtEndRule) IsSatisfied(index int, record *TradingRecord) bool {
+	return index == fcer.series.LastIndex() && record.CurrentPosition().IsOpen()
+}
+
+// CircuitBreakerRule is satisfied while the strategy trading into its record is allowed to open new positions. It
+// trips once the record's most recent closed trades include a run of at least maxConsecutiveLosses unprofitable
+// trades, classified as in Streaks, and stays tripped until Reset is called, even if later trades are profitable.
+// Combine it with an entry rule using And to stop a broken strategy from bleeding out.
+type CircuitBreakerRule struct {
+	record               *TradingRecord
+	maxConsecutiveLosses int
+	resetAt              int
+	tripped              bool
+}
+
+// NewCircuitBreakerRule returns a new CircuitBreakerRule that watches record and trips after maxConsecutiveLosses
+// consecutive losing trades. NewCircuitBreakerRule panics if maxConsecutiveLosses is less than 1.
+func NewCircuitBreakerRule(record *TradingRecord, maxConsecutiveLosses int) *CircuitBreakerRule {
+	if maxConsecutiveLosses < 1 {
+		panic(fmt.Errorf("error creating circuit breaker rule: max consecutive losses must be at least 1, got %d", maxConsecutiveLosses))
+	}
+
+	return &CircuitBreakerRule{
+		record:               record,
+		maxConsecutiveLosses: maxConsecutiveLosses,
+	}
+}
+
+// IsSatisfied returns false once the circuit breaker has tripped, and true otherwise
+func (cbr *CircuitBreakerRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if cbr.tripped {
+		return false
+	}
+
+	streaks := Streaks(&TradingRecord{Trades: cbr.record.Trades[cbr.resetAt:]})
+	if len(streaks) > 0 {
+		last := streaks[len(streaks)-1]
+		cbr.tripped = !last.Profitable && last.Count >= cbr.maxConsecutiveLosses
+	}
+
+	return !cbr.tripped
+}
+
+// Reset closes the circuit breaker, allowing new entries again. Losses recorded before Reset no longer count towards
+// the next trip.
+func (cbr *CircuitBreakerRule) Reset() {
+	cbr.tripped = false
+	cbr.resetAt = len(cbr.record.Trades)
+}
+
+type afterOutcomeRule struct {
+	record     *TradingRecord
+	requireWin bool
+}
+
+// NewAfterOutcomeRule returns a new rule that is satisfied when the most recent closed trade in record was profitable
+// (requireWin) or unprofitable (!requireWin), classified as in Streaks. It's also satisfied while record has no closed
+// trades. Combine it with an entry rule using And, e.g., to only enter after a losing trade.
+func NewAfterOutcomeRule(record *TradingRecord, requireWin bool) Rule {
+	return afterOutcomeRule{
+		record:     record,
+		requireWin: requireWin,
+	}
+}
+
+func (aor afterOutcomeRule) IsSatisfied(index int, record *TradingRecord) bool {
+	lastTrade := aor.record.LastTrade()
+	if lastTrade == nil {
+		return true
+	}
+
+	return isProfitable(lastTrade) == aor.requireWin
+}
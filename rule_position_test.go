@@ -53,3 +53,135 @@ func TestPositionOpenRule(t *testing.T) {
 		assert.True(t, rule.IsSatisfied(0, record))
 	})
 }
+
+func TestMaxOpenPositionsRule(t *testing.T) {
+	portfolio := NewPortfolio()
+	rule := NewMaxOpenPositionsRule(portfolio, 2)
+
+	assert.True(t, rule.IsSatisfied(0, portfolio.Record("A")))
+
+	portfolio.Record("A").Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE})
+	assert.True(t, rule.IsSatisfied(0, portfolio.Record("B")))
+
+	portfolio.Record("B").Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE})
+	assert.False(t, rule.IsSatisfied(0, portfolio.Record("C")))
+
+	portfolio.Record("A").Operate(Order{Side: SELL, Amount: big.ONE, Price: big.ONE})
+	assert.True(t, rule.IsSatisfied(0, portfolio.Record("C")))
+}
+
+func TestForceCloseAtEndRule(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3)
+	rule := NewForceCloseAtEndRule(series)
+
+	t.Run("returns false when position new", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(2, NewTradingRecord()))
+	})
+
+	t.Run("returns true only at last index when position open", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE})
+
+		assert.False(t, rule.IsSatisfied(1, record))
+		assert.True(t, rule.IsSatisfied(2, record))
+	})
+
+	t.Run("flattens open position in backtest", func(t *testing.T) {
+		strategy := RuleStrategy{
+			EntryRule:      alwaysSatisfiedRule{},
+			ExitRule:       Or(Not(alwaysSatisfiedRule{}), rule),
+			UnstablePeriod: -1,
+		}
+
+		record := RunStrategy(strategy, series)
+
+		assert.Len(t, record.Trades, 1)
+		assert.True(t, record.CurrentPosition().IsNew())
+		assert.EqualValues(t, 3, record.LastTrade().ExitOrder().Price.Float())
+	})
+}
+
+func TestCircuitBreakerRule(t *testing.T) {
+	trade := func(record *TradingRecord, entry, exit float64) {
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(entry)})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(exit)})
+	}
+
+	t.Run("trips after consecutive losses", func(t *testing.T) {
+		record := NewTradingRecord()
+		rule := NewCircuitBreakerRule(record, 2)
+
+		assert.True(t, rule.IsSatisfied(0, record))
+
+		trade(record, 2, 1)
+		trade(record, 1, 3)
+		trade(record, 3, 2)
+		assert.True(t, rule.IsSatisfied(0, record))
+
+		trade(record, 2, 1)
+		assert.False(t, rule.IsSatisfied(0, record))
+	})
+
+	t.Run("stays tripped until reset", func(t *testing.T) {
+		record := NewTradingRecord()
+		rule := NewCircuitBreakerRule(record, 1)
+
+		trade(record, 2, 1)
+		assert.False(t, rule.IsSatisfied(0, record))
+
+		trade(record, 1, 2)
+		assert.False(t, rule.IsSatisfied(0, record))
+
+		rule.Reset()
+		assert.True(t, rule.IsSatisfied(0, record))
+
+		trade(record, 2, 1)
+		assert.False(t, rule.IsSatisfied(0, record))
+	})
+
+	t.Run("losses before reset do not count", func(t *testing.T) {
+		record := NewTradingRecord()
+		rule := NewCircuitBreakerRule(record, 2)
+
+		trade(record, 2, 1)
+		trade(record, 2, 1)
+		assert.False(t, rule.IsSatisfied(0, record))
+
+		rule.Reset()
+		trade(record, 2, 1)
+		assert.True(t, rule.IsSatisfied(0, record))
+	})
+
+	t.Run("panics with invalid max", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewCircuitBreakerRule(NewTradingRecord(), 0)
+		})
+	})
+}
+
+func TestAfterOutcomeRule(t *testing.T) {
+	record := NewTradingRecord()
+	afterWin := NewAfterOutcomeRule(record, true)
+	afterLoss := NewAfterOutcomeRule(record, false)
+
+	t.Run("returns true with no prior trades", func(t *testing.T) {
+		assert.True(t, afterWin.IsSatisfied(0, record))
+		assert.True(t, afterLoss.IsSatisfied(0, record))
+	})
+
+	t.Run("after a win", func(t *testing.T) {
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(1)})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(2)})
+
+		assert.True(t, afterWin.IsSatisfied(0, record))
+		assert.False(t, afterLoss.IsSatisfied(0, record))
+	})
+
+	t.Run("after a loss", func(t *testing.T) {
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(2)})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(1)})
+
+		assert.False(t, afterWin.IsSatisfied(0, record))
+		assert.True(t, afterLoss.IsSatisfied(0, record))
+	})
+}
@@ -0,0 +1,83 @@
+package techan
+
+import (
+	"fmt"
+
+	"github.com/sdcoffey/big"
+)
+
+// swing is the range between the highest high and the lowest low of a window of candles. The swing is up when the
+// highest high came after the lowest low, or on the same candle, and down otherwise.
+type swing struct {
+	high big.Decimal
+	low  big.Decimal
+	up   bool
+}
+
+// findSwing returns the swing over the lookback candles ending at index. When several candles share the highest high
+// or lowest low, the earliest of them is used.
+func findSwing(series *TimeSeries, index, lookback int) swing {
+	start := index - lookback + 1
+	highIndex, lowIndex := start, start
+	for i := start + 1; i <= index; i++ {
+		if series.Candles[i].MaxPrice.GT(series.Candles[highIndex].MaxPrice) {
+			highIndex = i
+		}
+		if series.Candles[i].MinPrice.LT(series.Candles[lowIndex].MinPrice) {
+			lowIndex = i
+		}
+	}
+
+	return swing{
+		high: series.Candles[highIndex].MaxPrice,
+		low:  series.Candles[lowIndex].MinPrice,
+		up:   highIndex >= lowIndex,
+	}
+}
+
+type retracementRule struct {
+	series     *TimeSeries
+	lookback   int
+	minRetrace big.Decimal
+	maxRetrace big.Decimal
+}
+
+// NewRetracementRule returns a new rule that is satisfied when the close price has pulled back between minRetrace and
+// maxRetrace, inclusive, of the most recent swing. The swing runs between the highest high and the lowest low of the
+// lookback candles ending at the current one. If the high came after the low, the swing is up, and the retracement is
+// how far the close has fallen from the high as a fraction of the swing; otherwise the swing is down, and the
+// retracement is how far the close has risen from the low. For example, a minRetrace of 0.382 and a maxRetrace of 0.618
+// express a Fibonacci pullback entry. The rule is not satisfied before lookback candles are available, or while the
+// swing has no range. NewRetracementRule panics if lookback is less than 2.
+func NewRetracementRule(series *TimeSeries, lookback int, minRetrace, maxRetrace float64) Rule {
+	if lookback < 2 {
+		panic(fmt.Errorf("error creating retracement rule: lookback must be at least 2, got %d", lookback))
+	}
+
+	return retracementRule{
+		series:     series,
+		lookback:   lookback,
+		minRetrace: big.NewDecimal(minRetrace),
+		maxRetrace: big.NewDecimal(maxRetrace),
+	}
+}
+
+func (rr retracementRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if index < rr.lookback-1 {
+		return false
+	}
+
+	s := findSwing(rr.series, index, rr.lookback)
+	height := s.high.Sub(s.low)
+	if height.IsZero() {
+		return false
+	}
+
+	close := rr.series.Candles[index].ClosePrice
+	retracement := close.Sub(s.low).Div(height)
+	if s.up {
+		retracement = s.high.Sub(close).Div(height)
+	}
+
+	return retracement.GTE(rr.minRetrace) && retracement.LTE(rr.maxRetrace)
+}
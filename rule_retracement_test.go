@@ -0,0 +1,50 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetracementRule(t *testing.T) {
+	t.Run("Returns false before lookback candles are available", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 20, 15)
+
+		assert.False(t, NewRetracementRule(series, 4, 0.382, 0.618).IsSatisfied(2, nil))
+	})
+
+	t.Run("Pullback in an up swing", func(t *testing.T) {
+		// Highs and lows are close +/- 1, so the swing runs from a low of 9 to a high of 21
+		series := mockTimeSeriesFl(10, 20, 15, 12, 19)
+		rule := NewRetracementRule(series, 3, 0.382, 0.618)
+
+		assert.True(t, rule.IsSatisfied(2, nil))  // (21 - 15) / (21 - 9) = 0.5
+		assert.False(t, rule.IsSatisfied(3, nil)) // down from 21 to 11: (12 - 11) / (21 - 11) = 0.1
+		assert.False(t, rule.IsSatisfied(4, nil)) // (20 - 19) / (20 - 11) = 0.11
+	})
+
+	t.Run("Bounce in a down swing", func(t *testing.T) {
+		series := mockTimeSeriesFl(20, 10, 15, 18)
+		rule := NewRetracementRule(series, 3, 0.382, 0.618)
+
+		assert.True(t, rule.IsSatisfied(2, nil)) // (15 - 9) / (21 - 9) = 0.5
+
+		rule = NewRetracementRule(series, 4, 0.382, 0.618)
+		assert.False(t, rule.IsSatisfied(3, nil)) // (18 - 9) / (21 - 9) = 0.75
+	})
+
+	t.Run("Returns false while the swing has no range", func(t *testing.T) {
+		series := mockTimeSeriesOCHL(
+			[]float64{10, 10, 10, 10},
+			[]float64{10, 10, 10, 10},
+		)
+
+		assert.False(t, NewRetracementRule(series, 2, 0, 1).IsSatisfied(1, nil))
+	})
+
+	t.Run("Panics with invalid lookback", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewRetracementRule(mockTimeSeriesFl(1), 1, 0.382, 0.618)
+		})
+	})
+}
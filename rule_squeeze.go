@@ -0,0 +1,38 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+type squeezeRule struct {
+	bbUpper Indicator
+	bbLower Indicator
+	kcUpper Indicator
+	kcLower Indicator
+}
+
+// NewSqueezeRule returns a new rule that is satisfied while the bollinger bands of the close price (over window, at
+// bbSigma standard deviations) lie entirely inside its keltner channels (over window, at kcMult average true ranges),
+// a sign of compressed volatility that often precedes a breakout, as in the TTM Squeeze. The rule is not satisfied
+// before the keltner channels are available. The squeeze fires when the bands expand back out of the channels, which
+// can be expressed as And(Not(squeeze), OnBarClose(squeeze)).
+func NewSqueezeRule(series *TimeSeries, window int, bbSigma, kcMult float64) Rule {
+	closePrice := NewClosePriceIndicator(series)
+	atr := NewAverageTrueRangeIndicator(series, window)
+	ema := NewEMAIndicator(closePrice, window)
+
+	return squeezeRule{
+		bbUpper: NewBollingerUpperBandIndicator(closePrice, window, bbSigma),
+		bbLower: NewBollingerLowerBandIndicator(closePrice, window, bbSigma),
+		kcUpper: keltnerChannelIndicator{ema: ema, atr: atr, mul: big.NewDecimal(kcMult), window: window},
+		kcLower: keltnerChannelIndicator{ema: ema, atr: atr, mul: big.NewDecimal(-kcMult), window: window},
+	}
+}
+
+func (sr squeezeRule) IsSatisfied(index int, record *TradingRecord) bool {
+	kcUpper := sr.kcUpper.Calculate(index)
+	kcLower := sr.kcLower.Calculate(index)
+	if kcUpper.IsZero() && kcLower.IsZero() {
+		return false
+	}
+
+	return sr.bbUpper.Calculate(index).LT(kcUpper) && sr.bbLower.Calculate(index).GT(kcLower)
+}
@@ -0,0 +1,29 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSqueezeRule(t *testing.T) {
+	series := mockTimeSeriesFl(10, 10, 10, 10, 10, 10, 30)
+	squeeze := NewSqueezeRule(series, 3, 2, 1.5)
+
+	t.Run("Returns false before keltner channels are available", func(t *testing.T) {
+		assert.False(t, squeeze.IsSatisfied(2, nil))
+	})
+
+	t.Run("Bands inside channels", func(t *testing.T) {
+		assert.True(t, squeeze.IsSatisfied(4, nil))
+		assert.True(t, squeeze.IsSatisfied(5, nil))
+	})
+
+	t.Run("Bands expand out of channels", func(t *testing.T) {
+		assert.False(t, squeeze.IsSatisfied(6, nil))
+
+		fired := And(Not(squeeze), OnBarClose(squeeze))
+		assert.False(t, fired.IsSatisfied(5, nil))
+		assert.True(t, fired.IsSatisfied(6, nil))
+	})
+}
@@ -31,10 +31,14 @@ type takeProfitRule struct {
 	tolerance big.Decimal
 }
 
+// NewTakeProfitRule returns a new rule that is satisfied when the unrealized gain of the open position (a percentage)
+// meets or exceeds the given tolerance. The gain is measured from the entrance price to the current close, and is
+// positive when the close is above the entrance price for long positions, or below it for short positions.
+// Tolerance should be a value between 0 and 1.
 func NewTakeProfitRule(series *TimeSeries, tolerance float64) Rule {
 	return takeProfitRule{
 		Indicator: NewClosePriceIndicator(series),
-		tolerance: big.NewDecimal(1.0 + tolerance),
+		tolerance: big.NewDecimal(tolerance),
 	}
 }
 
@@ -43,9 +47,84 @@ func (tpr takeProfitRule) IsSatisfied(index int, record *TradingRecord) bool {
 		return false
 	}
 
-	amount := record.CurrentPosition().EntranceOrder().Amount
+	openPrice := record.CurrentPosition().EntranceOrder().Price
+	gain := tpr.Indicator.Calculate(index).Sub(openPrice).Div(openPrice)
+	if record.CurrentPosition().IsShort() {
+		gain = gain.Neg()
+	}
+
+	return gain.GTE(tpr.tolerance)
+}
+
+type atrStopRule struct {
+	close      Indicator
+	atr        Indicator
+	multiplier big.Decimal
+}
+
+// NewATRStopRule returns a new rule that is satisfied when the close price has moved against the open position by at
+// least multiplier times the current average true range (over atrWindow) from the entrance price, i.e., at or below
+// entrance - multiplier*ATR for long positions, and at or above entrance + multiplier*ATR for short positions. The rule
+// is not satisfied while no position is open, or before the average true range is available.
+func NewATRStopRule(series *TimeSeries, atrWindow int, multiplier float64) Rule {
+	return atrStopRule{
+		close:      NewClosePriceIndicator(series),
+		atr:        NewAverageTrueRangeIndicator(series, atrWindow),
+		multiplier: big.NewDecimal(multiplier),
+	}
+}
+
+func (asr atrStopRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if !record.CurrentPosition().IsOpen() {
+		return false
+	}
+
+	atr := asr.atr.Calculate(index)
+	if atr.IsZero() {
+		return false
+	}
+
+	openPrice := record.CurrentPosition().EntranceOrder().Price
+	distance := atr.Mul(asr.multiplier)
+	close := asr.close.Calculate(index)
+
+	if record.CurrentPosition().IsShort() {
+		return close.GTE(openPrice.Add(distance))
+	}
+
+	return close.LTE(openPrice.Sub(distance))
+}
+
+type profitLockRule struct {
+	series       *TimeSeries
+	lockFraction float64
+	key          positionKey
+	peak         float64
+}
+
+// NewProfitLockRule returns a new rule that is satisfied once the open position's unrealized profit, per OpenPLAnalysis,
+// falls below lockFraction (between 0 and 1) times its peak over the candles evaluated since the position opened.
+func NewProfitLockRule(series *TimeSeries, lockFraction float64) Rule {
+	return &profitLockRule{
+		series:       series,
+		lockFraction: lockFraction,
+	}
+}
+
+func (plr *profitLockRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if !record.CurrentPosition().IsOpen() {
+		return false
+	}
+
+	if key := currentPositionKey(record); key != plr.key {
+		plr.key = key
+		plr.peak = 0
+	}
+
+	profit := OpenPLAnalysis{LastCandle: plr.series.Candles[index]}.Analyze(record)
+	if profit > plr.peak {
+		plr.peak = profit
+	}
 
-	openPrice := record.CurrentPosition().CostBasis()
-	win := tpr.Indicator.Calculate(index).Mul(amount).Div(openPrice)
-	return win.GTE(tpr.tolerance)
+	return plr.peak > 0 && profit < plr.lockFraction*plr.peak
 }
@@ -2,6 +2,7 @@ package techan
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sdcoffey/big"
 	"github.com/stretchr/testify/assert"
@@ -62,3 +63,151 @@ func TestStopLossRule(t *testing.T) {
 		assert.False(t, slr.IsSatisfied(1, record))
 	})
 }
+
+func TestTakeProfitRule(t *testing.T) {
+	t.Run("Returns false when position is new or closed", func(t *testing.T) {
+		record := NewTradingRecord()
+
+		series := mockTimeSeriesFl(1, 2, 3, 4)
+
+		tpr := NewTakeProfitRule(series, 0.1)
+
+		assert.False(t, tpr.IsSatisfied(3, record))
+	})
+
+	t.Run("Long position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{
+			Side:   BUY,
+			Amount: big.NewFromString("10"),
+			Price:  big.NewDecimal(10),
+		})
+
+		series := mockTimeSeriesFl(10, 10.5, 11, 9) // Gain 5%, gain 10%, lose 10%
+
+		tpr := NewTakeProfitRule(series, 0.1)
+
+		assert.False(t, tpr.IsSatisfied(1, record))
+		assert.True(t, tpr.IsSatisfied(2, record))
+		assert.False(t, tpr.IsSatisfied(3, record))
+	})
+
+	t.Run("Short position becomes profitable as price falls", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{
+			Side:   SELL,
+			Amount: big.NewFromString("10"),
+			Price:  big.NewDecimal(10),
+		})
+
+		series := mockTimeSeriesFl(10, 9.5, 8, 11) // Gain 5%, gain 20%, lose 10%
+
+		tpr := NewTakeProfitRule(series, 0.2)
+
+		assert.False(t, tpr.IsSatisfied(1, record))
+		assert.True(t, tpr.IsSatisfied(2, record))
+		assert.False(t, tpr.IsSatisfied(3, record))
+	})
+}
+
+func TestATRStopRule(t *testing.T) {
+	t.Run("Returns false when position is new", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 10, 10, 9, 7)
+
+		assert.False(t, NewATRStopRule(series, 2, 1).IsSatisfied(4, NewTradingRecord()))
+	})
+
+	t.Run("Long position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+
+		series := mockTimeSeriesFl(10, 10, 10, 9, 7) // ATR 2, 2, 2.5
+
+		rule := NewATRStopRule(series, 2, 1)
+
+		assert.False(t, rule.IsSatisfied(1, record))
+		assert.False(t, rule.IsSatisfied(3, record))
+		assert.True(t, rule.IsSatisfied(4, record))
+
+		assert.False(t, NewATRStopRule(series, 2, 2).IsSatisfied(4, record))
+	})
+
+	t.Run("Short position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(10)})
+
+		series := mockTimeSeriesFl(10, 10, 10, 11, 13) // ATR 2, 2, 2.5
+
+		rule := NewATRStopRule(series, 2, 1)
+
+		assert.False(t, rule.IsSatisfied(3, record))
+		assert.True(t, rule.IsSatisfied(4, record))
+	})
+}
+
+func TestProfitLockRule(t *testing.T) {
+	t.Run("Returns false when position is new", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 12, 10)
+
+		assert.False(t, NewProfitLockRule(series, 0.5).IsSatisfied(2, NewTradingRecord()))
+	})
+
+	t.Run("Long position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+
+		series := mockTimeSeriesFl(9, 14, 12, 11.5) // Profit -1, 4, 2, 1.5
+
+		rule := NewProfitLockRule(series, 0.5)
+
+		assert.False(t, rule.IsSatisfied(0, record))
+		assert.False(t, rule.IsSatisfied(1, record))
+		assert.False(t, rule.IsSatisfied(2, record))
+		assert.True(t, rule.IsSatisfied(3, record))
+	})
+
+	t.Run("Short position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(10)})
+
+		series := mockTimeSeriesFl(6, 8) // Profit 4, 2
+
+		rule := NewProfitLockRule(series, 0.75)
+
+		assert.False(t, rule.IsSatisfied(0, record))
+		assert.True(t, rule.IsSatisfied(1, record))
+	})
+
+	t.Run("Resets when a new position opens", func(t *testing.T) {
+		now := time.Now()
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: now})
+
+		series := mockTimeSeriesFl(20, 11, 12)
+
+		rule := NewProfitLockRule(series, 0.5)
+		assert.False(t, rule.IsSatisfied(0, record))
+
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(20), ExecutionTime: now.Add(time.Minute)})
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10), ExecutionTime: now.Add(time.Minute * 2)})
+
+		assert.False(t, rule.IsSatisfied(1, record))
+		assert.False(t, rule.IsSatisfied(2, record))
+	})
+
+	t.Run("Resets between positions without execution times", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+
+		series := mockTimeSeriesFl(20, 11, 12)
+
+		rule := NewProfitLockRule(series, 0.5)
+		assert.False(t, rule.IsSatisfied(0, record))
+
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(20)})
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(10)})
+
+		assert.False(t, rule.IsSatisfied(1, record))
+		assert.False(t, rule.IsSatisfied(2, record))
+	})
+}
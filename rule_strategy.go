@@ -0,0 +1,33 @@
+package techan
+
+type strategyEntryRule struct {
+	strategy Strategy
+}
+
+// NewStrategyEntryRule returns a new rule that is satisfied when the given strategy's ShouldEnter is satisfied. This
+// allows the entry logic of several strategies to be combined with And, Or, or AtLeast rules into a new strategy.
+//
+// The trading record passed to IsSatisfied is handed to the strategy as-is, so the strategy sees the positions of the
+// outer strategy rather than a record of its own. A RuleStrategy, for example, will only signal entry while the outer
+// record has no open position.
+func NewStrategyEntryRule(s Strategy) Rule {
+	return strategyEntryRule{strategy: s}
+}
+
+func (ser strategyEntryRule) IsSatisfied(index int, record *TradingRecord) bool {
+	return ser.strategy.ShouldEnter(index, record)
+}
+
+type strategyExitRule struct {
+	strategy Strategy
+}
+
+// NewStrategyExitRule returns a new rule that is satisfied when the given strategy's ShouldExit is satisfied. As with
+// NewStrategyEntryRule, the trading record passed to IsSatisfied is handed to the strategy as-is.
+func NewStrategyExitRule(s Strategy) Rule {
+	return strategyExitRule{strategy: s}
+}
+
+func (ser strategyExitRule) IsSatisfied(index int, record *TradingRecord) bool {
+	return ser.strategy.ShouldExit(index, record)
+}
@@ -0,0 +1,68 @@
+package techan
+
+import (
+	"testing"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrategyEntryRule(t *testing.T) {
+	s := RuleStrategy{
+		EntryRule: alwaysSatisfiedRule{},
+		ExitRule:  alwaysSatisfiedRule{},
+	}
+
+	rule := NewStrategyEntryRule(s)
+	record := NewTradingRecord()
+
+	assert.False(t, rule.IsSatisfied(0, record))
+	assert.True(t, rule.IsSatisfied(1, record))
+
+	record.Operate(Order{
+		Side:   BUY,
+		Amount: big.ONE,
+		Price:  big.ONE,
+	})
+
+	assert.False(t, rule.IsSatisfied(2, record))
+}
+
+func TestStrategyExitRule(t *testing.T) {
+	s := RuleStrategy{
+		EntryRule: alwaysSatisfiedRule{},
+		ExitRule:  alwaysSatisfiedRule{},
+	}
+
+	rule := NewStrategyExitRule(s)
+	record := NewTradingRecord()
+
+	assert.False(t, rule.IsSatisfied(1, record))
+
+	record.Operate(Order{
+		Side:   BUY,
+		Amount: big.ONE,
+		Price:  big.ONE,
+	})
+
+	assert.True(t, rule.IsSatisfied(2, record))
+}
+
+func TestStrategyRules_Composed(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3, 4, 5)
+	price := NewClosePriceIndicator(series)
+
+	above := RuleStrategy{
+		EntryRule: OverIndicatorRule{First: price, Second: NewConstantIndicator(1.5)},
+		ExitRule:  alwaysSatisfiedRule{},
+	}
+	never := RuleStrategy{
+		EntryRule: UnderIndicatorRule{First: price, Second: NewConstantIndicator(0)},
+		ExitRule:  alwaysSatisfiedRule{},
+	}
+
+	record := NewTradingRecord()
+
+	assert.True(t, Or(NewStrategyEntryRule(above), NewStrategyEntryRule(never)).IsSatisfied(1, record))
+	assert.False(t, And(NewStrategyEntryRule(above), NewStrategyEntryRule(never)).IsSatisfied(1, record))
+}
@@ -58,6 +58,113 @@ func TestOrRule(t *testing.T) {
 	})
 }
 
+func TestAtLeastRule(t *testing.T) {
+	t.Run("enough rules satisfied", func(t *testing.T) {
+		rule := NewAtLeastRule(2, truthRule{}, falseRule{}, truthRule{})
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+	})
+
+	t.Run("too few rules satisfied", func(t *testing.T) {
+		rule := NewAtLeastRule(2, truthRule{}, falseRule{}, falseRule{})
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+	})
+
+	t.Run("panics when k is out of range", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewAtLeastRule(0, truthRule{})
+		})
+		assert.Panics(t, func() {
+			NewAtLeastRule(3, truthRule{}, truthRule{})
+		})
+	})
+}
+
+type recordingRule struct {
+	name  string
+	calls *[]string
+}
+
+func (rr recordingRule) IsSatisfied(index int, record *TradingRecord) bool {
+	*rr.calls = append(*rr.calls, rr.name)
+	return true
+}
+
+func TestWeightedSignalRule(t *testing.T) {
+	strong, weak, missing := truthRule{}, Not(falseRule{}), falseRule{}
+
+	t.Run("satisfied weights meet threshold", func(t *testing.T) {
+		rule := NewWeightedSignalRule(3,
+			WeightedRule{Rule: strong, Weight: 2},
+			WeightedRule{Rule: weak, Weight: 1},
+			WeightedRule{Rule: missing, Weight: 5},
+		)
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+	})
+
+	t.Run("satisfied weights fall short of threshold", func(t *testing.T) {
+		rule := NewWeightedSignalRule(3,
+			WeightedRule{Rule: strong, Weight: 2},
+			WeightedRule{Rule: weak, Weight: 0.5},
+			WeightedRule{Rule: missing, Weight: 5},
+		)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+	})
+
+	t.Run("accepts rules that are not comparable", func(t *testing.T) {
+		rule := NewWeightedSignalRule(2,
+			WeightedRule{Rule: NewAtLeastRule(1, strong, missing), Weight: 1},
+			WeightedRule{Rule: NewAtLeastRule(2, strong, weak), Weight: 1},
+		)
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+	})
+
+	t.Run("evaluates rules in order", func(t *testing.T) {
+		var calls []string
+		rule := NewWeightedSignalRule(3,
+			WeightedRule{Rule: recordingRule{name: "first", calls: &calls}, Weight: 1},
+			WeightedRule{Rule: recordingRule{name: "second", calls: &calls}, Weight: 1},
+			WeightedRule{Rule: recordingRule{name: "third", calls: &calls}, Weight: 1},
+		)
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+		assert.EqualValues(t, []string{"first", "second", "third"}, calls)
+	})
+
+	t.Run("panics when a weight is negative", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewWeightedSignalRule(1, WeightedRule{Rule: strong, Weight: 2}, WeightedRule{Rule: weak, Weight: -1})
+		})
+	})
+}
+
+func TestOnBarClose(t *testing.T) {
+	price := NewFixedIndicator(1, 3, 2, 4)
+	level := NewConstantIndicator(2.5)
+
+	t.Run("threshold rule", func(t *testing.T) {
+		rule := OnBarClose(OverIndicatorRule{First: price, Second: level})
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("cross rule", func(t *testing.T) {
+		cross := NewCrossUpIndicatorRule(level, price)
+		rule := OnBarClose(cross)
+
+		assert.True(t, cross.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+	})
+}
+
 func TestOverIndicatorRule(t *testing.T) {
 	highIndicator := NewConstantIndicator(1)
 	lowIndicator := NewConstantIndicator(0)
@@ -121,3 +228,102 @@ func TestPercentChangeRule(t *testing.T) {
 		assert.True(t, rule.IsSatisfied(1, record))
 	})
 }
+
+func TestNearRule(t *testing.T) {
+	reference := NewFixedIndicator(100, 100, 100, 100, 0)
+	price := NewFixedIndicator(100, 102, 97, 95, 0)
+
+	rule := NewNearRule(price, reference, 0.03)
+
+	t.Run("returns true when price is within tolerance", func(t *testing.T) {
+		assert.True(t, rule.IsSatisfied(0, nil))
+		assert.True(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+	})
+
+	t.Run("returns false when price is outside tolerance", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("returns false when reference is zero", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(4, nil))
+	})
+}
+
+func TestSeparationRule(t *testing.T) {
+	b := NewFixedIndicator(100, 100, 100, -10, 0)
+	a := NewFixedIndicator(105, 102, 90, -9, 1)
+
+	t.Run("positive margin", func(t *testing.T) {
+		rule := NewSeparationRule(a, b, 0.05)
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.True(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("negative margin", func(t *testing.T) {
+		rule := NewSeparationRule(a, b, -0.05)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+	})
+
+	t.Run("returns false when b is zero", func(t *testing.T) {
+		assert.False(t, NewSeparationRule(a, b, 0.05).IsSatisfied(4, nil))
+	})
+}
+
+func TestTrendFilterRule(t *testing.T) {
+	series := mockTimeSeriesFl(10, 10, 10, 14, 6)
+
+	t.Run("long", func(t *testing.T) {
+		rule := NewTrendFilterRule(series, 3, true)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.True(t, rule.IsSatisfied(3, nil))
+		assert.False(t, rule.IsSatisfied(4, nil))
+	})
+
+	t.Run("short", func(t *testing.T) {
+		rule := NewTrendFilterRule(series, 3, false)
+
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+		assert.False(t, rule.IsSatisfied(3, nil))
+		assert.True(t, rule.IsSatisfied(4, nil))
+	})
+}
+
+func TestPriorityExitRule(t *testing.T) {
+	stop := OverIndicatorRule{First: NewFixedIndicator(0, 1, 0, 1), Second: NewConstantIndicator(0)}
+	target := OverIndicatorRule{First: NewFixedIndicator(0, 1, 1, 0), Second: NewConstantIndicator(0)}
+
+	rule := NewPriorityExitRule(stop, target)
+
+	t.Run("returns -1 before any rule fires", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.EqualValues(t, -1, rule.LastTriggered())
+	})
+
+	t.Run("earlier rules take priority", func(t *testing.T) {
+		assert.True(t, rule.IsSatisfied(1, nil))
+		assert.EqualValues(t, 0, rule.LastTriggered())
+	})
+
+	t.Run("later rules fire when earlier ones do not", func(t *testing.T) {
+		assert.True(t, rule.IsSatisfied(2, nil))
+		assert.EqualValues(t, 1, rule.LastTriggered())
+	})
+
+	t.Run("keeps the last trigger when nothing fires", func(t *testing.T) {
+		assert.False(t, rule.IsSatisfied(0, nil))
+		assert.EqualValues(t, 1, rule.LastTriggered())
+	})
+}
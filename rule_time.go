@@ -0,0 +1,142 @@
+package techan
+
+import "time"
+
+type exitAtTimeRule struct {
+	series   *TimeSeries
+	exitTime time.Time
+}
+
+// NewExitAtTimeRule returns a new rule that is satisfied when a position is open and the candle at the given index
+// starts at or after exitTime. It's useful for flattening positions ahead of a known event.
+func NewExitAtTimeRule(series *TimeSeries, exitTime time.Time) Rule {
+	return exitAtTimeRule{
+		series:   series,
+		exitTime: exitTime,
+	}
+}
+
+func (eatr exitAtTimeRule) IsSatisfied(index int, record *TradingRecord) bool {
+	if !record.CurrentPosition().IsOpen() {
+		return false
+	}
+
+	return !eatr.series.Candles[index].Period.Start.Before(eatr.exitTime)
+}
+
+type weekdayRule struct {
+	series *TimeSeries
+	days   map[time.Weekday]bool
+}
+
+// NewWeekdayRule returns a new rule that is satisfied when the candle at the given index starts on one of the given
+// days of the week. The day is taken in the location of the candle's start time, so use TimePeriod.In to evaluate a
+// series in a different time zone.
+func NewWeekdayRule(series *TimeSeries, days ...time.Weekday) Rule {
+	allowed := make(map[time.Weekday]bool, len(days))
+	for _, day := range days {
+		allowed[day] = true
+	}
+
+	return weekdayRule{
+		series: series,
+		days:   allowed,
+	}
+}
+
+func (wr weekdayRule) IsSatisfied(index int, record *TradingRecord) bool {
+	return wr.days[wr.series.Candles[index].Period.Start.Weekday()]
+}
+
+// calendarDay is the date of a time in its own location
+type calendarDay struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func calendarDayOf(t time.Time) calendarDay {
+	year, month, day := t.Date()
+	return calendarDay{year, month, day}
+}
+
+type blackoutRule struct {
+	series     *TimeSeries
+	days       map[calendarDay]bool
+	windowBars int
+}
+
+// NewBlackoutRule returns a new rule that is satisfied unless the candle at the given index is within windowBars
+// candles of a blackout date, e.g., a scheduled earnings release. A candle falls on a blackout date when it starts on
+// the same calendar day, with the candle's day taken in the location of its start time as in NewWeekdayRule, and the
+// blackout date's day in its own location. The rule is not satisfied on a candle falling on a blackout date, or on any
+// of the windowBars candles before or after such a candle. Candles after the end of the series, which a live series
+// has not received yet, are projected by advancing the last candle's period, so the candles leading up to a blackout
+// date are blocked as they arrive. Combine it with an entry rule using And to stay out of the market around scheduled
+// events.
+func NewBlackoutRule(series *TimeSeries, dates []time.Time, windowBars int) Rule {
+	days := make(map[calendarDay]bool, len(dates))
+	for _, date := range dates {
+		days[calendarDayOf(date)] = true
+	}
+
+	return blackoutRule{
+		series:     series,
+		days:       days,
+		windowBars: windowBars,
+	}
+}
+
+func (br blackoutRule) IsSatisfied(index int, record *TradingRecord) bool {
+	last := br.series.LastIndex()
+
+	for i := Max(index-br.windowBars, 0); i <= index+br.windowBars; i++ {
+		period := br.series.Candles[Min(i, last)].Period
+		if i > last {
+			period = period.Advance(i - last)
+		}
+
+		if br.days[calendarDayOf(period.Start)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+type oncePerDayRule struct {
+	series *TimeSeries
+	record *TradingRecord
+}
+
+// NewOncePerDayRule returns a new rule that is satisfied unless a position has already been entered in record on the
+// calendar day of the candle at the given index. Days are compared in the location of the candle's start time, so
+// entrance times in other locations are converted to it first. Combine it with an entry rule using And to enter at most
+// once per day.
+func NewOncePerDayRule(series *TimeSeries, record *TradingRecord) Rule {
+	return oncePerDayRule{
+		series: series,
+		record: record,
+	}
+}
+
+func (opdr oncePerDayRule) IsSatisfied(index int, record *TradingRecord) bool {
+	start := opdr.series.Candles[index].Period.Start
+	today := calendarDayOf(start)
+
+	enteredToday := func(position *Position) bool {
+		return calendarDayOf(position.EntranceOrder().ExecutionTime.In(start.Location())) == today
+	}
+
+	if opdr.record.CurrentPosition().IsOpen() && enteredToday(opdr.record.CurrentPosition()) {
+		return false
+	}
+
+	for _, trade := range opdr.record.Trades {
+		if enteredToday(trade) {
+			return false
+		}
+	}
+
+	return true
+}
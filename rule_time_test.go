@@ -0,0 +1,172 @@
+package techan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitAtTimeRule(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3, 4)
+	exitTime := series.Candles[2].Period.Start
+
+	t.Run("Returns false when position is new", func(t *testing.T) {
+		rule := NewExitAtTimeRule(series, exitTime)
+
+		assert.False(t, rule.IsSatisfied(3, NewTradingRecord()))
+	})
+
+	t.Run("Returns true at or after exit time when position is open", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{
+			Side:          BUY,
+			Amount:        big.ONE,
+			Price:         big.ONE,
+			ExecutionTime: series.Candles[0].Period.Start,
+		})
+
+		rule := NewExitAtTimeRule(series, exitTime)
+
+		assert.False(t, rule.IsSatisfied(1, record))
+		assert.True(t, rule.IsSatisfied(2, record))
+		assert.True(t, rule.IsSatisfied(3, record))
+	})
+
+	t.Run("Returns false before exit time", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{
+			Side:   BUY,
+			Amount: big.ONE,
+			Price:  big.ONE,
+		})
+
+		rule := NewExitAtTimeRule(series, exitTime.Add(time.Hour))
+
+		assert.False(t, rule.IsSatisfied(3, record))
+	})
+}
+
+func TestWeekdayRule(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	series := NewTimeSeries()
+	for _, start := range []time.Time{
+		time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC),            // Monday
+		time.Date(2021, 3, 2, 12, 0, 0, 0, time.UTC),            // Tuesday
+		time.Date(2021, 3, 5, 12, 0, 0, 0, time.UTC),            // Friday
+		time.Date(2021, 3, 8, 2, 0, 0, 0, time.UTC).In(newYork), // Sunday evening in New York, Monday in UTC
+	} {
+		series.AddCandle(NewCandle(NewTimePeriod(start, time.Hour)))
+	}
+
+	t.Run("Returns true on allowed days", func(t *testing.T) {
+		rule := NewWeekdayRule(series, time.Monday, time.Friday)
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+	})
+
+	t.Run("Uses location of candle start", func(t *testing.T) {
+		assert.False(t, NewWeekdayRule(series, time.Monday).IsSatisfied(3, nil))
+		assert.True(t, NewWeekdayRule(series, time.Sunday).IsSatisfied(3, nil))
+	})
+
+	t.Run("Returns false with no days", func(t *testing.T) {
+		assert.False(t, NewWeekdayRule(series).IsSatisfied(0, nil))
+	})
+}
+
+func TestBlackoutRule(t *testing.T) {
+	series := NewTimeSeries()
+	for day := 1; day <= 7; day++ {
+		series.AddCandle(NewCandle(NewTimePeriod(time.Date(2021, 3, day, 14, 30, 0, 0, time.UTC), time.Hour)))
+	}
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	t.Run("Blocks candles near blackout dates", func(t *testing.T) {
+		rule := NewBlackoutRule(series, []time.Time{time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)}, 1)
+
+		expected := []bool{true, true, false, false, false, true, true}
+		for i, want := range expected {
+			assert.EqualValues(t, want, rule.IsSatisfied(i, nil), "index %d", i)
+		}
+	})
+
+	t.Run("Matches by calendar day", func(t *testing.T) {
+		// Early on the 2nd in Tokyo is still the 1st in UTC, but the 2nd is the blackout day
+		rule := NewBlackoutRule(series, []time.Time{time.Date(2021, 3, 2, 1, 0, 0, 0, tokyo)}, 0)
+
+		assert.True(t, rule.IsSatisfied(0, nil))
+		assert.False(t, rule.IsSatisfied(1, nil))
+		assert.True(t, rule.IsSatisfied(2, nil))
+	})
+
+	t.Run("Returns true with no dates", func(t *testing.T) {
+		assert.True(t, NewBlackoutRule(series, nil, 3).IsSatisfied(3, nil))
+	})
+
+	t.Run("Blocks the last candle before a blackout date", func(t *testing.T) {
+		live := NewTimeSeries()
+		for day := 1; day <= 3; day++ {
+			live.AddCandle(NewCandle(NewTimePeriod(time.Date(2021, 3, day, 0, 0, 0, 0, time.UTC), time.Hour*24)))
+		}
+
+		rule := NewBlackoutRule(live, []time.Time{time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC)}, 2)
+
+		assert.True(t, rule.IsSatisfied(1, nil))
+		assert.False(t, rule.IsSatisfied(2, nil))
+
+		dayBefore := NewBlackoutRule(live, []time.Time{time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)}, 1)
+		assert.True(t, dayBefore.IsSatisfied(1, nil))
+		assert.False(t, dayBefore.IsSatisfied(2, nil))
+	})
+}
+
+func TestOncePerDayRule(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	series := NewTimeSeries()
+	for _, start := range []time.Time{
+		time.Date(2021, 3, 1, 10, 0, 0, 0, newYork),
+		time.Date(2021, 3, 1, 15, 0, 0, 0, newYork),
+		time.Date(2021, 3, 2, 10, 0, 0, 0, newYork),
+	} {
+		series.AddCandle(NewCandle(NewTimePeriod(start, time.Hour)))
+	}
+
+	record := NewTradingRecord()
+	rule := NewOncePerDayRule(series, record)
+
+	t.Run("Returns true before any entry", func(t *testing.T) {
+		assert.True(t, rule.IsSatisfied(0, record))
+	})
+
+	t.Run("Returns false once a position is entered that day", func(t *testing.T) {
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: series.Candles[0].Period.Start})
+		assert.False(t, rule.IsSatisfied(1, record))
+
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.ONE, ExecutionTime: series.Candles[0].Period.End})
+		assert.False(t, rule.IsSatisfied(1, record))
+	})
+
+	t.Run("Returns true on the next day", func(t *testing.T) {
+		assert.True(t, rule.IsSatisfied(2, record))
+	})
+
+	t.Run("Compares days in the location of the series", func(t *testing.T) {
+		// Late on March 1st in New York is already March 2nd in UTC
+		utcRecord := NewTradingRecord()
+		utcRecord.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.ONE, ExecutionTime: time.Date(2021, 3, 2, 3, 0, 0, 0, time.UTC)})
+
+		utcRule := NewOncePerDayRule(series, utcRecord)
+		assert.False(t, utcRule.IsSatisfied(0, utcRecord))
+		assert.True(t, utcRule.IsSatisfied(2, utcRecord))
+	})
+}
@@ -0,0 +1,45 @@
+package techan
+
+import "github.com/sdcoffey/big"
+
+// AntiMartingaleSizer sizes positions in proportion to how close the equity of Record is to its peak, so that size
+// shrinks during drawdowns and recovers along with equity. The equity curve starts at StartingCapital and moves by the
+// profit of each closed trade in Record.
+type AntiMartingaleSizer struct {
+	Base            float64
+	StartingCapital float64
+	Record          *TradingRecord
+}
+
+// Size returns Base reduced by the current drawdown of the equity curve from its peak, e.g., Base at a new equity high,
+// and half of Base while equity is 50% below its peak. Size is never negative.
+func (ams AntiMartingaleSizer) Size() float64 {
+	_, drawdowns := UnderwaterCurve(ams.Record, ams.StartingCapital)
+	if len(drawdowns) == 0 {
+		return ams.Base
+	}
+
+	scale := 1 + drawdowns[len(drawdowns)-1]
+	if scale < 0 {
+		return 0
+	}
+
+	return ams.Base * scale
+}
+
+// VolatilityTargetSizer sizes positions so that each carries the same expected volatility, regardless of how volatile
+// the instrument is. TargetVol is the fraction of capital a position should be expected to move in a single candle, and
+// ATR is an average true range indicator, such as one returned by NewAverageTrueRangeIndicator, which gives the expected
+// move of a single unit.
+type VolatilityTargetSizer struct {
+	TargetVol float64
+	ATR       Indicator
+}
+
+// Size returns the number of units whose ATR-implied volatility at index equals TargetVol of capital, i.e.,
+// capital * TargetVol / ATR. Size returns zero while the ATR is zero.
+func (vts VolatilityTargetSizer) Size(capital float64, index int) big.Decimal {
+	budget := big.NewDecimal(capital * vts.TargetVol)
+
+	return SafeDivide(budget, vts.ATR.Calculate(index), big.ZERO)
+}
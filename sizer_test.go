@@ -0,0 +1,75 @@
+package techan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sdcoffey/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAntiMartingaleSizer_Size(t *testing.T) {
+	trade := func(record *TradingRecord, entry, exit float64) {
+		var start time.Time
+		if record.LastTrade() != nil {
+			start = record.LastTrade().ExitOrder().ExecutionTime.Add(time.Minute)
+		}
+
+		record.Operate(Order{Side: BUY, Amount: big.ONE, Price: big.NewDecimal(entry), ExecutionTime: start})
+		record.Operate(Order{Side: SELL, Amount: big.ONE, Price: big.NewDecimal(exit), ExecutionTime: start.Add(time.Minute)})
+	}
+
+	t.Run("Returns base with no trades", func(t *testing.T) {
+		sizer := AntiMartingaleSizer{Base: 10, StartingCapital: 100, Record: NewTradingRecord()}
+
+		assert.EqualValues(t, 10, sizer.Size())
+	})
+
+	t.Run("Returns base at equity peak", func(t *testing.T) {
+		record := NewTradingRecord()
+		trade(record, 10, 30)
+
+		sizer := AntiMartingaleSizer{Base: 10, StartingCapital: 100, Record: record}
+
+		assert.EqualValues(t, 10, sizer.Size())
+	})
+
+	t.Run("Shrinks in proportion to drawdown", func(t *testing.T) {
+		record := NewTradingRecord()
+		trade(record, 10, 30) // equity 120
+		trade(record, 40, 10) // equity 90, 25% below peak
+
+		sizer := AntiMartingaleSizer{Base: 10, StartingCapital: 100, Record: record}
+
+		assert.InDelta(t, 7.5, sizer.Size(), 1e-9)
+	})
+
+	t.Run("Never negative", func(t *testing.T) {
+		record := NewTradingRecord()
+		trade(record, 200, 50) // equity -50
+
+		sizer := AntiMartingaleSizer{Base: 10, StartingCapital: 100, Record: record}
+
+		assert.EqualValues(t, 0, sizer.Size())
+	})
+}
+
+func TestVolatilityTargetSizer_Size(t *testing.T) {
+	sizer := VolatilityTargetSizer{TargetVol: 0.01, ATR: NewFixedIndicator(0, 2, 5)}
+
+	t.Run("Returns zero while ATR is zero", func(t *testing.T) {
+		decimalEquals(t, 0, sizer.Size(10000, 0))
+	})
+
+	t.Run("Sizes inversely to ATR", func(t *testing.T) {
+		decimalEquals(t, 50, sizer.Size(10000, 1))
+		decimalEquals(t, 20, sizer.Size(10000, 2))
+	})
+
+	t.Run("Works with the ATR indicator", func(t *testing.T) {
+		series := mockTimeSeriesFl(10, 10, 10)
+		atrSizer := VolatilityTargetSizer{TargetVol: 0.01, ATR: NewAverageTrueRangeIndicator(series, 2)}
+
+		decimalEquals(t, 50, atrSizer.Size(10000, 2))
+	})
+}
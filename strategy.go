@@ -1,5 +1,7 @@
 package techan
 
+import "github.com/sdcoffey/big"
+
 // Strategy is an interface that describes desired entry and exit trading behavior
 type Strategy interface {
 	ShouldEnter(index int, record *TradingRecord) bool
@@ -39,3 +41,30 @@ func (rs RuleStrategy) ShouldExit(index int, record *TradingRecord) bool {
 
 	return false
 }
+
+// RunStrategy backtests the strategy over every candle in the series and returns the resulting trading record.
+// Positions are entered with a one-unit buy and exited with a one-unit sell at the close price of the candle on which
+// the strategy signaled.
+func RunStrategy(strategy Strategy, series *TimeSeries) *TradingRecord {
+	record := NewTradingRecord()
+
+	for index, candle := range series.Candles {
+		var side OrderSide
+		if strategy.ShouldEnter(index, record) {
+			side = BUY
+		} else if strategy.ShouldExit(index, record) {
+			side = SELL
+		} else {
+			continue
+		}
+
+		record.Operate(Order{
+			Side:          side,
+			Price:         candle.ClosePrice,
+			Amount:        big.ONE,
+			ExecutionTime: candle.Period.Start,
+		})
+	}
+
+	return record
+}
@@ -128,3 +128,20 @@ func TestRuleStrategy_ShouldExit(t *testing.T) {
 		})
 	})
 }
+
+func TestRunStrategy(t *testing.T) {
+	series := mockTimeSeriesFl(1, 2, 3, 4, 3, 2)
+
+	closePrice := NewClosePriceIndicator(series)
+	s := RuleStrategy{
+		EntryRule: OverIndicatorRule{First: NewConstantIndicator(2.5), Second: closePrice},
+		ExitRule:  OverIndicatorRule{First: closePrice, Second: NewConstantIndicator(3.5)},
+	}
+
+	record := RunStrategy(s, series)
+
+	assert.Len(t, record.Trades, 1)
+	assert.EqualValues(t, "2", record.Trades[0].EntranceOrder().Price.String())
+	assert.EqualValues(t, "4", record.Trades[0].ExitOrder().Price.String())
+	assert.True(t, record.CurrentPosition().IsOpen())
+}
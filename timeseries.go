@@ -2,6 +2,8 @@ package techan
 
 import (
 	"fmt"
+
+	"github.com/sdcoffey/big"
 )
 
 // TimeSeries represents an array of candles
@@ -45,3 +47,44 @@ func (ts *TimeSeries) LastCandle() *Candle {
 func (ts *TimeSeries) LastIndex() int {
 	return len(ts.Candles) - 1
 }
+
+// VolumeBars returns a new TimeSeries in which consecutive candles of this series are aggregated until their combined
+// volume reaches volumePerBar. Each bar spans the periods of the candles it aggregates, opening at the first candle's
+// open, closing at the last candle's close, and carrying the accumulated high, low, volume and trade count. Candles
+// remaining after the last full bar are emitted as a final, partial bar.
+func (ts *TimeSeries) VolumeBars(volumePerBar float64) *TimeSeries {
+	if volumePerBar <= 0 {
+		panic(fmt.Errorf("error creating volume bars: volumePerBar must be greater than zero"))
+	}
+
+	threshold := big.NewDecimal(volumePerBar)
+	bars := NewTimeSeries()
+
+	var bar *Candle
+	for _, candle := range ts.Candles {
+		if bar == nil {
+			bar = NewCandle(candle.Period)
+			bar.OpenPrice = candle.OpenPrice
+			bar.MaxPrice = candle.MaxPrice
+			bar.MinPrice = candle.MinPrice
+		}
+
+		bar.Period.End = candle.Period.End
+		bar.ClosePrice = candle.ClosePrice
+		bar.MaxPrice = big.MaxSlice(bar.MaxPrice, candle.MaxPrice)
+		bar.MinPrice = big.MinSlice(bar.MinPrice, candle.MinPrice)
+		bar.Volume = bar.Volume.Add(candle.Volume)
+		bar.TradeCount += candle.TradeCount
+
+		if bar.Volume.GTE(threshold) {
+			bars.AddCandle(bar)
+			bar = nil
+		}
+	}
+
+	if bar != nil {
+		bars.AddCandle(bar)
+	}
+
+	return bars
+}
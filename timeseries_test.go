@@ -84,3 +84,57 @@ func TestTimeSeries_LastIndex(t *testing.T) {
 
 	assert.EqualValues(t, 1, ts.LastIndex())
 }
+
+func TestTimeSeries_VolumeBars(t *testing.T) {
+	t.Run("Panics with non-positive volume", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewTimeSeries().VolumeBars(0)
+		})
+	})
+
+	t.Run("Aggregates candles until volume threshold", func(t *testing.T) {
+		ts := mockTimeSeriesOCHL(
+			[]float64{10, 11, 12, 9},
+			[]float64{11, 12, 13, 10},
+			[]float64{12, 10, 14, 8},
+			[]float64{10, 11, 11, 10},
+			[]float64{11, 13, 13, 11},
+			[]float64{13, 12, 15, 12},
+		) // volumes 0, 1, 2, 3, 4, 5
+
+		bars := ts.VolumeBars(3)
+
+		assert.Len(t, bars.Candles, 4)
+
+		first := bars.Candles[0]
+		assert.EqualValues(t, ts.Candles[0].Period.Start.UnixNano(), first.Period.Start.UnixNano())
+		assert.EqualValues(t, ts.Candles[2].Period.End.UnixNano(), first.Period.End.UnixNano())
+		assert.EqualValues(t, 10, first.OpenPrice.Float())
+		assert.EqualValues(t, 10, first.ClosePrice.Float())
+		assert.EqualValues(t, 14, first.MaxPrice.Float())
+		assert.EqualValues(t, 8, first.MinPrice.Float())
+		assert.EqualValues(t, 3, first.Volume.Float())
+
+		second := bars.Candles[1]
+		assert.EqualValues(t, 10, second.OpenPrice.Float())
+		assert.EqualValues(t, 11, second.ClosePrice.Float())
+		assert.EqualValues(t, 3, second.Volume.Float())
+
+		assert.EqualValues(t, 4, bars.Candles[2].Volume.Float())
+		assert.EqualValues(t, 5, bars.Candles[3].Volume.Float())
+	})
+
+	t.Run("Emits final partial bar", func(t *testing.T) {
+		ts := mockTimeSeriesOCHL(
+			[]float64{10, 11, 12, 9},
+			[]float64{11, 12, 13, 10},
+			[]float64{12, 10, 14, 8},
+		) // volumes 0, 1, 2
+
+		bars := ts.VolumeBars(10)
+
+		assert.Len(t, bars.Candles, 1)
+		assert.EqualValues(t, 3, bars.LastCandle().Volume.Float())
+		assert.EqualValues(t, 10, bars.LastCandle().ClosePrice.Float())
+	})
+}
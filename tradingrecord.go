@@ -1,5 +1,14 @@
 package techan
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sdcoffey/big"
+)
+
 // TradingRecord is an object describing a series of trades made and a current position
 type TradingRecord struct {
 	Trades          []*Position
@@ -49,3 +58,165 @@ func (tr *TradingRecord) Operate(order Order) {
 		tr.currentPosition.Enter(order)
 	}
 }
+
+type orderJSON struct {
+	Side          string    `json:"side"`
+	Security      string    `json:"security"`
+	Price         string    `json:"price"`
+	Amount        string    `json:"amount"`
+	ExecutionTime time.Time `json:"executionTime"`
+}
+
+type positionJSON struct {
+	Entrance   *orderJSON `json:"entrance"`
+	Exit       *orderJSON `json:"exit,omitempty"`
+	StopLoss   string     `json:"stopLoss,omitempty"`
+	TakeProfit string     `json:"takeProfit,omitempty"`
+}
+
+type tradingRecordJSON struct {
+	Trades          []positionJSON `json:"trades"`
+	CurrentPosition *positionJSON  `json:"currentPosition"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. Every trade is written with its entrance and exit orders and its
+// stop loss and take profit prices, which are omitted when not set, followed by the current position, which is null
+// when no position is open. Prices and amounts are written as strings holding their exact decimal value, so a record
+// survives a round trip through UnmarshalJSON unchanged. This relies on big.MarshalQuoted being left at its default of
+// false; when it is set, decimals are written as by Decimal.String and may lose precision.
+func (tr *TradingRecord) MarshalJSON() ([]byte, error) {
+	out := tradingRecordJSON{
+		Trades: make([]positionJSON, len(tr.Trades)),
+	}
+
+	for i, trade := range tr.Trades {
+		out.Trades[i] = newPositionJSON(trade)
+	}
+
+	if !tr.currentPosition.IsNew() {
+		current := newPositionJSON(tr.currentPosition)
+		out.CurrentPosition = &current
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, restoring a record written by MarshalJSON
+func (tr *TradingRecord) UnmarshalJSON(b []byte) error {
+	var in tradingRecordJSON
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+
+	trades := make([]*Position, len(in.Trades))
+	for i, trade := range in.Trades {
+		position, err := trade.position()
+		if err != nil {
+			return err
+		}
+		trades[i] = position
+	}
+
+	currentPosition := new(Position)
+	if in.CurrentPosition != nil {
+		position, err := in.CurrentPosition.position()
+		if err != nil {
+			return err
+		}
+		currentPosition = position
+	}
+
+	tr.Trades = trades
+	tr.currentPosition = currentPosition
+
+	return nil
+}
+
+func newPositionJSON(position *Position) positionJSON {
+	var out positionJSON
+	if position.EntranceOrder() != nil {
+		out.Entrance = newOrderJSON(position.EntranceOrder())
+	}
+	if position.ExitOrder() != nil {
+		out.Exit = newOrderJSON(position.ExitOrder())
+	}
+	if !position.stopLossPrice.NaN() {
+		out.StopLoss = decimalText(position.stopLossPrice)
+	}
+	if !position.takeProfitPrice.NaN() {
+		out.TakeProfit = decimalText(position.takeProfitPrice)
+	}
+
+	return out
+}
+
+func (pj positionJSON) position() (*Position, error) {
+	position := new(Position)
+	for i, oj := range []*orderJSON{pj.Entrance, pj.Exit} {
+		if oj == nil {
+			continue
+		}
+
+		order, err := oj.order()
+		if err != nil {
+			return nil, err
+		}
+		position.orders[i] = &order
+	}
+
+	if pj.StopLoss != "" {
+		position.stopLossPrice = big.NewFromString(pj.StopLoss)
+	}
+	if pj.TakeProfit != "" {
+		position.takeProfitPrice = big.NewFromString(pj.TakeProfit)
+	}
+
+	return position, nil
+}
+
+func newOrderJSON(order *Order) *orderJSON {
+	side := "BUY"
+	if order.Side == SELL {
+		side = "SELL"
+	}
+
+	return &orderJSON{
+		Side:          side,
+		Security:      order.Security,
+		Price:         decimalText(order.Price),
+		Amount:        decimalText(order.Amount),
+		ExecutionTime: order.ExecutionTime,
+	}
+}
+
+func (oj orderJSON) order() (Order, error) {
+	var side OrderSide
+	switch oj.Side {
+	case "BUY":
+		side = BUY
+	case "SELL":
+		side = SELL
+	default:
+		return Order{}, fmt.Errorf("error unmarshaling order: unknown side %q", oj.Side)
+	}
+
+	return Order{
+		Side:          side,
+		Security:      oj.Security,
+		Price:         big.NewFromString(oj.Price),
+		Amount:        big.NewFromString(oj.Amount),
+		ExecutionTime: oj.ExecutionTime,
+	}, nil
+}
+
+// decimalText returns the shortest string that parses back to exactly d. Unlike Decimal.String, which is limited to
+// ten significant digits, this does not lose precision, unless big.MarshalQuoted is set, in which case the big package
+// itself falls back to Decimal.String.
+func decimalText(d big.Decimal) string {
+	if d.NaN() {
+		return d.String()
+	}
+
+	b, _ := d.MarshalJSON()
+	return strings.Trim(string(b), `"`)
+}
@@ -1,6 +1,7 @@
 package techan
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -103,3 +104,90 @@ func TestTradingRecord_Exit(t *testing.T) {
 		assert.True(t, record.CurrentPosition().IsOpen())
 	})
 }
+
+func TestTradingRecord_MarshalJSON(t *testing.T) {
+	entrance := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	exit := entrance.Add(time.Hour)
+
+	t.Run("Writes trades and open position", func(t *testing.T) {
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Security: example, Price: big.NewFromString("10.5"), Amount: big.ONE, ExecutionTime: entrance})
+		record.Operate(Order{Side: SELL, Security: example, Price: big.NewFromString("12"), Amount: big.ONE, ExecutionTime: exit})
+		record.Operate(Order{Side: SELL, Security: example, Price: big.NewFromString("11"), Amount: big.TEN, ExecutionTime: exit})
+
+		b, err := json.Marshal(record)
+		assert.NoError(t, err)
+
+		expected := `{"trades":[{"entrance":{"side":"BUY","security":"EXM","price":"10.5","amount":"1","executionTime":"2020-01-02T03:04:05Z"},` +
+			`"exit":{"side":"SELL","security":"EXM","price":"12","amount":"1","executionTime":"2020-01-02T04:04:05Z"}}],` +
+			`"currentPosition":{"entrance":{"side":"SELL","security":"EXM","price":"11","amount":"10","executionTime":"2020-01-02T04:04:05Z"}}}`
+		assert.JSONEq(t, expected, string(b))
+	})
+
+	t.Run("Writes null when no position is open", func(t *testing.T) {
+		b, err := json.Marshal(NewTradingRecord())
+		assert.NoError(t, err)
+
+		assert.JSONEq(t, `{"trades":[],"currentPosition":null}`, string(b))
+	})
+}
+
+func TestTradingRecord_UnmarshalJSON(t *testing.T) {
+	t.Run("Round trips", func(t *testing.T) {
+		entrance := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+
+		record := NewTradingRecord()
+		record.Operate(Order{Side: SELL, Price: big.NewFromString("0.123456789012345"), Amount: big.NewFromString("3"), ExecutionTime: entrance})
+		record.Operate(Order{Side: BUY, Price: big.NewFromString("0.1"), Amount: big.NewFromString("3"), ExecutionTime: entrance.Add(time.Minute)})
+		record.Operate(Order{Side: BUY, Price: big.NewFromString("2"), Amount: big.ONE, ExecutionTime: entrance.Add(time.Hour)})
+
+		b, err := json.Marshal(record)
+		assert.NoError(t, err)
+
+		restored := NewTradingRecord()
+		assert.NoError(t, json.Unmarshal(b, restored))
+
+		assert.Len(t, restored.Trades, 1)
+		trade := restored.Trades[0]
+		assert.True(t, trade.IsShort())
+		assert.EqualValues(t, "0.123456789012345", decimalText(trade.EntranceOrder().Price))
+		assert.EqualValues(t, "3", decimalText(trade.EntranceOrder().Amount))
+		assert.True(t, entrance.Equal(trade.EntranceOrder().ExecutionTime))
+		assert.EqualValues(t, "0.1", decimalText(trade.ExitOrder().Price))
+
+		assert.True(t, restored.CurrentPosition().IsOpen())
+		assert.True(t, restored.CurrentPosition().IsLong())
+		assert.EqualValues(t, 2, restored.CurrentPosition().EntranceOrder().Price.Float())
+	})
+
+	t.Run("Round trips stop loss and take profit", func(t *testing.T) {
+		entrance := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		record := NewTradingRecord()
+		record.Operate(Order{Side: BUY, Price: big.NewFromString("10"), Amount: big.ONE, ExecutionTime: entrance})
+		record.CurrentPosition().ChangeStopLoss(big.NewFromString("9.123456789012345"))
+		record.CurrentPosition().ChangeTakeProfit(big.NewFromString("12.5"))
+		record.Operate(Order{Side: SELL, Price: big.NewFromString("12.5"), Amount: big.ONE, ExecutionTime: entrance.Add(time.Hour)})
+		record.Operate(Order{Side: BUY, Price: big.NewFromString("11"), Amount: big.ONE, ExecutionTime: entrance.Add(time.Hour * 2)})
+		record.CurrentPosition().ChangeStopLoss(big.NewFromString("10.5"))
+
+		b, err := json.Marshal(record)
+		assert.NoError(t, err)
+
+		restored := NewTradingRecord()
+		assert.NoError(t, json.Unmarshal(b, restored))
+
+		assert.Len(t, restored.Trades, 1)
+		assert.True(t, record.Trades[0].stopLossPrice.EQ(restored.Trades[0].stopLossPrice))
+		assert.EqualValues(t, "12.5", decimalText(restored.Trades[0].takeProfitPrice))
+
+		assert.EqualValues(t, "10.5", decimalText(restored.CurrentPosition().stopLossPrice))
+		assert.True(t, restored.CurrentPosition().takeProfitPrice.NaN())
+	})
+
+	t.Run("Returns error for unknown side", func(t *testing.T) {
+		b := []byte(`{"trades":[{"entrance":{"side":"HOLD","price":"1","amount":"1"}}],"currentPosition":null}`)
+
+		assert.Error(t, json.Unmarshal(b, NewTradingRecord()))
+	})
+}